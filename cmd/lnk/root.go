@@ -11,7 +11,11 @@ import (
 )
 
 // Global flags
-var jsonOutput bool
+var (
+	jsonOutput bool
+	dryRun     bool
+	assumeYes  bool
+)
 
 // rootCmd represents the base command when called without any subcommands.
 var rootCmd = &cobra.Command{
@@ -42,6 +46,8 @@ func Execute() {
 func init() {
 	// Global flags available to all commands
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output in JSON format (agent-friendly)")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print requests that would change LinkedIn instead of sending them")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Skip confirmation prompts (required with --json)")
 
 	// Disable default completion command
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
@@ -52,5 +58,15 @@ func init() {
 	rootCmd.AddCommand(commands.NewFeedCmd())
 	rootCmd.AddCommand(commands.NewPostCmd())
 	rootCmd.AddCommand(commands.NewSearchCmd())
+	rootCmd.AddCommand(commands.NewJobCmd())
+	rootCmd.AddCommand(commands.NewLookupCmd())
 	rootCmd.AddCommand(commands.NewMessagesCmd())
+	rootCmd.AddCommand(commands.NewScheduleCmd())
+	rootCmd.AddCommand(commands.NewDraftCmd())
+	rootCmd.AddCommand(commands.NewCommentCmd())
+	rootCmd.AddCommand(commands.NewReactCmd())
+	rootCmd.AddCommand(commands.NewUnreactCmd())
+	rootCmd.AddCommand(commands.NewURNCmd())
+	rootCmd.AddCommand(commands.NewUndoCmd())
+	rootCmd.AddCommand(commands.NewAuditCmd())
 }
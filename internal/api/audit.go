@@ -0,0 +1,45 @@
+package api
+
+import "context"
+
+// Operation names reported to the auditor.
+const (
+	OpCreatePost                = "CreatePost"
+	OpCreatePoll                = "CreatePoll"
+	OpReshare                   = "Reshare"
+	OpDeletePost                = "DeletePost"
+	OpCreateComment             = "CreateComment"
+	OpReplyToComment            = "ReplyToComment"
+	OpDeleteComment             = "DeleteComment"
+	OpReact                     = "React"
+	OpUnreact                   = "Unreact"
+	OpSendMessage               = "SendMessage"
+	OpSendMessageToConversation = "SendMessageToConversation"
+)
+
+// Mutation describes a state-changing request that LinkedIn accepted.
+type Mutation struct {
+	// Operation is one of the Op constants.
+	Operation string
+	// Target is the URN acted on: the created post or comment, the deleted
+	// entity, the reacted-to item or the message recipient.
+	Target string
+	// Parent is the URN the target was created under, if any (the reshared
+	// post, the commented post or the replied-to comment).
+	Parent string
+	// Content is the text sent, if any.
+	Content string
+}
+
+// SetAuditor registers fn to be called after every successful mutation.
+// A nil fn disables auditing.
+func (c *Client) SetAuditor(fn func(context.Context, *Mutation)) {
+	c.auditor = fn
+}
+
+// audit reports a successful mutation to the auditor, if any.
+func (c *Client) audit(ctx context.Context, m *Mutation) {
+	if c.auditor != nil {
+		c.auditor(ctx, m)
+	}
+}
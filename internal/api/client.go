@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,8 +29,24 @@ type Client struct {
 	httpClient  *http.Client
 	baseURL     string
 	credentials *Credentials
+	dryRun      func(*DryRunRequest)
+	auditor     func(context.Context, *Mutation)
+	limiter     *rateLimiter
+
+	typeaheadCache TypeaheadCache
+}
+
+// DryRunRequest is a request that would have been sent in dry-run mode.
+type DryRunRequest struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
 }
 
+// ErrDryRun is returned instead of sending a request that changes state
+// while dry-run mode is on.
+var ErrDryRun = errors.New("dry run: request not sent")
+
 // ClientOption configures a Client.
 type ClientOption func(*Client)
 
@@ -79,6 +96,12 @@ func (c *Client) SetCredentials(creds *Credentials) {
 	c.credentials = creds
 }
 
+// SetDryRun enables dry-run mode: requests other than GET are passed to fn
+// instead of being sent, and fail with ErrDryRun. A nil fn disables it.
+func (c *Client) SetDryRun(fn func(*DryRunRequest)) {
+	c.dryRun = fn
+}
+
 // HasCredentials returns true if credentials are set and valid.
 func (c *Client) HasCredentials() bool {
 	return c.credentials != nil && c.credentials.IsValid()
@@ -101,11 +124,27 @@ func (c *Client) Do(ctx context.Context, req *Request, result any) error {
 		return err
 	}
 
+	if c.dryRun != nil && req.Method != http.MethodGet {
+		dr := &DryRunRequest{Method: req.Method, URL: httpReq.URL.String()}
+		if req.Body != nil {
+			dr.Body, _ = json.Marshal(req.Body)
+		}
+		c.dryRun(dr)
+		return ErrDryRun
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+	}
+
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
 		return &Error{
 			Code:    ErrCodeNetworkError,
 			Message: fmt.Sprintf("network error: %v", err),
+			Err:     err,
 		}
 	}
 	defer resp.Body.Close()
@@ -200,6 +239,7 @@ func (c *Client) handleResponse(resp *http.Response, result any) error {
 		return &Error{
 			Code:    ErrCodeNetworkError,
 			Message: fmt.Sprintf("failed to read response: %v", err),
+			Err:     err,
 		}
 	}
 
@@ -278,6 +318,11 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
 }
 
+// Unwrap returns the underlying transport error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 // Get performs a GET request.
 func (c *Client) Get(ctx context.Context, path string, query url.Values, result any) error {
 	return c.Do(ctx, &Request{
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -278,3 +279,102 @@ func TestErrorInterface(t *testing.T) {
 		t.Errorf("expected %q, got %q", expected, err.Error())
 	}
 }
+
+func TestClientDryRun(t *testing.T) {
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	var captured *DryRunRequest
+	c.SetDryRun(func(r *DryRunRequest) { captured = r })
+
+	if err := c.Get(context.Background(), "/me", nil, nil); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	err := c.Post(context.Background(), "/posts", map[string]string{"text": "hi"}, nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Post() error = %v, want ErrDryRun", err)
+	}
+
+	if len(sent) != 1 || sent[0] != http.MethodGet {
+		t.Errorf("sent = %v, want only the GET", sent)
+	}
+	if captured == nil || captured.Method != http.MethodPost || captured.URL != server.URL+"/posts" {
+		t.Fatalf("captured = %+v", captured)
+	}
+	if string(captured.Body) != `{"text":"hi"}` {
+		t.Errorf("Body = %s", captured.Body)
+	}
+}
+
+func TestClientAuditor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"status": {"urn": "urn:li:share:1"}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	var audited []Mutation
+	c.SetAuditor(func(_ context.Context, m *Mutation) { audited = append(audited, *m) })
+
+	if _, err := c.CreatePost(context.Background(), "Hello"); err != nil {
+		t.Fatalf("CreatePost() error: %v", err)
+	}
+	if err := c.DeletePost(context.Background(), "urn:li:share:1"); err == nil {
+		t.Fatal("DeletePost() expected error")
+	}
+
+	want := Mutation{Operation: OpCreatePost, Target: "urn:li:share:1", Content: "Hello"}
+	if len(audited) != 1 || audited[0] != want {
+		t.Errorf("audited = %+v, want only %+v", audited, want)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+		WithRateLimit(50*time.Millisecond),
+	)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := c.Get(context.Background(), "/me", nil, nil); err != nil {
+			t.Fatalf("Get() error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests took %s, want at least 100ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.Get(ctx, "/me", nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Get() with canceled context error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// CommentOptions configures comment fetching.
+type CommentOptions struct {
+	Limit int
+	Start int
+}
+
+// CommentPage is one page of comments on a post.
+type CommentPage struct {
+	// Comments holds the comments and replies on the page in API order, not
+	// yet threaded: a reply's parent may be on another page. Use
+	// ThreadComments once every wanted page has been fetched.
+	Comments []Comment
+	// Total is the number of comments reported by the API, or 0 if unknown.
+	Total int
+}
+
+// GetComments fetches one page of comments on a post.
+func (c *Client) GetComments(ctx context.Context, postURN string, opts *CommentOptions) (*CommentPage, error) {
+	if opts == nil {
+		opts = &CommentOptions{Limit: 20}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	query := url.Values{
+		"q":         {"comments"},
+		"updateId":  {postURN},
+		"sortOrder": {"RELEVANCE"},
+		"count":     {fmt.Sprintf("%d", opts.Limit)},
+		"start":     {fmt.Sprintf("%d", opts.Start)},
+	}
+
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/feed/comments", query, &result); err != nil {
+		return nil, err
+	}
+
+	comments, err := parseCommentsFromResponse(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CommentPage{Comments: comments}
+	if result.Paging != nil {
+		page.Total = result.Paging.Total
+	}
+	return page, nil
+}
+
+// parseCommentsFromResponse extracts comments and replies from a Voyager
+// response, in the order they appear.
+func parseCommentsFromResponse(resp *VoyagerResponse) ([]Comment, error) {
+	if resp == nil {
+		return nil, &Error{
+			Code:    ErrCodeServerError,
+			Message: "empty response",
+		}
+	}
+
+	profiles := extractProfilesFromIncluded(resp.Included)
+
+	var all []Comment
+	for _, raw := range resp.Included {
+		var entity struct {
+			Type      string `json:"$type"`
+			EntityURN string `json:"entityUrn"`
+			URN       string `json:"urn"`
+			ThreadURN string `json:"threadUrn"`
+			ParentURN string `json:"parentCommentUrn"`
+			Commenter map[string]struct {
+				MiniProfile string `json:"*miniProfile"`
+			} `json:"commenter"`
+			CommentV2 struct {
+				Text string `json:"text"`
+			} `json:"commentV2"`
+			CreatedTime  int64 `json:"createdTime"`
+			SocialDetail struct {
+				TotalSocialActivityCounts struct {
+					NumLikes    int `json:"numLikes"`
+					NumComments int `json:"numComments"`
+				} `json:"totalSocialActivityCounts"`
+			} `json:"socialDetail"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil {
+			continue
+		}
+		if !strings.HasSuffix(entity.Type, ".Comment") {
+			continue
+		}
+
+		comment := Comment{
+			URN:        entity.URN,
+			ThreadURN:  entity.ThreadURN,
+			ParentURN:  entity.ParentURN,
+			Text:       entity.CommentV2.Text,
+			LikeCount:  entity.SocialDetail.TotalSocialActivityCounts.NumLikes,
+			ReplyCount: entity.SocialDetail.TotalSocialActivityCounts.NumComments,
+		}
+		if comment.URN == "" {
+			comment.URN = entity.EntityURN
+		}
+		if entity.CreatedTime > 0 {
+			comment.CreatedAt = time.UnixMilli(entity.CreatedTime)
+		}
+		for _, actor := range entity.Commenter {
+			comment.AuthorURN = actor.MiniProfile
+			if p, ok := profiles[actor.MiniProfile]; ok {
+				comment.AuthorName = strings.TrimSpace(p.FirstName + " " + p.LastName)
+			}
+		}
+
+		all = append(all, comment)
+	}
+
+	return all, nil
+}
+
+// ThreadComments nests replies under their parent comments, keeping the
+// original order. Replies whose parent is not present are kept top-level.
+func ThreadComments(all []Comment) []Comment {
+	present := make(map[string]bool, len(all))
+	for _, c := range all {
+		present[c.URN] = true
+	}
+
+	replies := make(map[string][]Comment)
+	for _, c := range all {
+		if c.ParentURN != "" && present[c.ParentURN] {
+			replies[c.ParentURN] = append(replies[c.ParentURN], c)
+		}
+	}
+
+	comments := []Comment{}
+	for _, c := range all {
+		if c.ParentURN != "" && present[c.ParentURN] {
+			continue
+		}
+		c.Replies = replies[c.URN]
+		comments = append(comments, c)
+	}
+	return comments
+}
+
+// CreateComment adds a comment to a post. Mentions written as
+// @[Name](urn:li:fsd_profile:...) are sent as profile mentions.
+func (c *Client) CreateComment(ctx context.Context, postURN, text string) (*Comment, error) {
+	return c.createComment(ctx, postURN, "", text)
+}
+
+// ReplyToComment adds a reply to an existing comment.
+func (c *Client) ReplyToComment(ctx context.Context, commentURN, text string) (*Comment, error) {
+	return c.createComment(ctx, commentURN, commentURN, text)
+}
+
+func (c *Client) createComment(ctx context.Context, threadURN, parentURN, text string) (*Comment, error) {
+	if threadURN == "" {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: "URN is required"}
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: "comment text cannot be empty"}
+	}
+
+	plain, attributes := parseMentions(text)
+	payload := map[string]any{
+		"threadUrn": threadURN,
+		"commentV2": map[string]any{
+			"text":       plain,
+			"attributes": attributes,
+		},
+	}
+	if parentURN != "" {
+		payload["parentCommentUrn"] = parentURN
+	}
+
+	var result struct {
+		Data struct {
+			URN       string `json:"urn"`
+			EntityURN string `json:"entityUrn"`
+		} `json:"data"`
+	}
+	if err := c.Post(ctx, "/feed/comments", payload, &result); err != nil {
+		return nil, err
+	}
+
+	urn := result.Data.URN
+	if urn == "" {
+		urn = result.Data.EntityURN
+	}
+
+	op, parent := OpCreateComment, threadURN
+	if parentURN != "" {
+		op, parent = OpReplyToComment, parentURN
+	}
+	c.audit(ctx, &Mutation{Operation: op, Target: urn, Parent: parent, Content: text})
+	return &Comment{
+		URN:       urn,
+		ThreadURN: threadURN,
+		ParentURN: parentURN,
+		Text:      plain,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// DeleteComment deletes a comment or reply by URN.
+func (c *Client) DeleteComment(ctx context.Context, commentURN string) error {
+	// URL encode the URN.
+	encodedURN := url.PathEscape(commentURN)
+	if err := c.Delete(ctx, "/feed/comments/"+encodedURN); err != nil {
+		return err
+	}
+	c.audit(ctx, &Mutation{Operation: OpDeleteComment, Target: commentURN})
+	return nil
+}
+
+var reMention = regexp.MustCompile(`@\[([^\]]+)\]\((urn:li:[^)\s]+)\)`)
+
+// parseMentions replaces @[Name](urn) mentions with their display name and
+// returns the text attributes LinkedIn uses to link them. Offsets are in
+// UTF-16 code units.
+func parseMentions(text string) (string, []any) {
+	attributes := []any{}
+	var b strings.Builder
+	last := 0
+	for _, m := range reMention.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(text[last:m[0]])
+		name, urn := text[m[2]:m[3]], text[m[4]:m[5]]
+
+		attributes = append(attributes, map[string]any{
+			"start":  utf16Len(b.String()),
+			"length": utf16Len(name),
+			"type": map[string]any{
+				"com.linkedin.pemberly.text.Entity": map[string]any{
+					"urn": urn,
+				},
+			},
+		})
+
+		b.WriteString(name)
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+	return b.String(), attributes
+}
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	text, attrs := parseMentions("Thanks @[Jane Doe](urn:li:fsd_profile:ABC) and @[Bob](urn:li:fsd_profile:XYZ)!")
+
+	if text != "Thanks Jane Doe and Bob!" {
+		t.Errorf("text = %q", text)
+	}
+	if len(attrs) != 2 {
+		t.Fatalf("attributes = %d, want 2", len(attrs))
+	}
+
+	second := attrs[1].(map[string]any)
+	if second["start"] != 20 || second["length"] != 3 {
+		t.Errorf("second mention = %v, want start 20 length 3", second)
+	}
+	entity := second["type"].(map[string]any)["com.linkedin.pemberly.text.Entity"].(map[string]any)
+	if entity["urn"] != "urn:li:fsd_profile:XYZ" {
+		t.Errorf("urn = %v", entity["urn"])
+	}
+}
+
+func TestParseMentionsNone(t *testing.T) {
+	text, attrs := parseMentions("no mentions, just @someone")
+	if text != "no mentions, just @someone" || len(attrs) != 0 {
+		t.Errorf("got %q, %v", text, attrs)
+	}
+}
+
+func TestParseCommentsFromResponse(t *testing.T) {
+	resp := &VoyagerResponse{
+		Included: []json.RawMessage{
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.identity.shared.MiniProfile",
+				"entityUrn": "urn:li:fs_miniProfile:1",
+				"firstName": "Jane",
+				"lastName": "Doe"
+			}`),
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.feed.Comment",
+				"urn": "urn:li:comment:(activity:9,100)",
+				"commenter": {"com.linkedin.voyager.feed.MemberActor": {"*miniProfile": "urn:li:fs_miniProfile:1"}},
+				"commentV2": {"text": "Great post"},
+				"createdTime": 1700000000000,
+				"socialDetail": {"totalSocialActivityCounts": {"numLikes": 2, "numComments": 1}}
+			}`),
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.feed.Comment",
+				"urn": "urn:li:comment:(activity:9,101)",
+				"parentCommentUrn": "urn:li:comment:(activity:9,100)",
+				"commentV2": {"text": "Agreed"}
+			}`),
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.feed.Comment",
+				"urn": "urn:li:comment:(activity:9,102)",
+				"commentV2": {"text": "Second"}
+			}`),
+		},
+	}
+
+	all, err := parseCommentsFromResponse(resp)
+	if err != nil {
+		t.Fatalf("parseCommentsFromResponse error: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("parsed comments = %d, want 3", len(all))
+	}
+
+	comments := ThreadComments(all)
+	if len(comments) != 2 {
+		t.Fatalf("comments = %d, want 2", len(comments))
+	}
+
+	first := comments[0]
+	if first.AuthorName != "Jane Doe" || first.Text != "Great post" || first.LikeCount != 2 {
+		t.Errorf("first = %+v", first)
+	}
+	if len(first.Replies) != 1 || first.Replies[0].Text != "Agreed" {
+		t.Errorf("Replies = %+v", first.Replies)
+	}
+	if comments[1].Text != "Second" {
+		t.Errorf("second = %+v", comments[1])
+	}
+}
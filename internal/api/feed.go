@@ -0,0 +1,514 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/urn"
+)
+
+// maxReshareDepth limits how deeply nested reshares are decoded.
+const maxReshareDepth = 2
+
+// entityIndex maps entity URNs to the raw entities of a normalized
+// response, so "*field" references can be followed.
+type entityIndex map[string]json.RawMessage
+
+// newEntityIndex indexes the included entities of a response.
+func newEntityIndex(included []json.RawMessage) entityIndex {
+	index := make(entityIndex, len(included))
+	for _, raw := range included {
+		var entity struct {
+			EntityURN string `json:"entityUrn"`
+		}
+		if err := json.Unmarshal(raw, &entity); err == nil && entity.EntityURN != "" {
+			index[entity.EntityURN] = raw
+		}
+	}
+	return index
+}
+
+// decode unmarshals the entity referenced by ref into v. It reports whether
+// the entity was found.
+func (idx entityIndex) decode(ref string, v any) bool {
+	raw, ok := idx[ref]
+	if !ok || ref == "" {
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
+
+// textView is LinkedIn's wrapper around rendered text.
+type textView struct {
+	Text string `json:"text"`
+}
+
+// navigationContext holds the link target of a rendered element.
+type navigationContext struct {
+	ActionTarget string `json:"actionTarget"`
+}
+
+// vectorImage is an image available in several renditions.
+type vectorImage struct {
+	RootURL   string `json:"rootUrl"`
+	Artifacts []struct {
+		Width                         int    `json:"width"`
+		Height                        int    `json:"height"`
+		FileIdentifyingURLPathSegment string `json:"fileIdentifyingUrlPathSegment"`
+	} `json:"artifacts"`
+}
+
+// largest returns the URL and size of the largest rendition.
+func (v *vectorImage) largest() (string, int, int) {
+	if v == nil {
+		return "", 0, 0
+	}
+	best := -1
+	for i, a := range v.Artifacts {
+		if best < 0 || a.Width > v.Artifacts[best].Width {
+			best = i
+		}
+	}
+	if best < 0 {
+		return v.RootURL, 0, 0
+	}
+	a := v.Artifacts[best]
+	return v.RootURL + a.FileIdentifyingURLPathSegment, a.Width, a.Height
+}
+
+// imageView is a rendered image; its first attribute carries the image
+// itself or the member or company it depicts.
+type imageView struct {
+	Attributes []struct {
+		VectorImage *vectorImage `json:"vectorImage"`
+		DetailData  struct {
+			VectorImage             *vectorImage `json:"vectorImage"`
+			NonEntityProfilePicture struct {
+				VectorImage *vectorImage `json:"vectorImage"`
+			} `json:"nonEntityProfilePicture"`
+		} `json:"detailData"`
+		MiniProfile    *miniProfile `json:"miniProfile"`
+		MiniProfileRef string       `json:"*miniProfile"`
+		MiniCompany    *miniCompany `json:"miniCompany"`
+		MiniCompanyRef string       `json:"*miniCompany"`
+	} `json:"attributes"`
+}
+
+// vector returns the first vector image among the attributes.
+func (iv *imageView) vector() *vectorImage {
+	if iv == nil {
+		return nil
+	}
+	for _, a := range iv.Attributes {
+		switch {
+		case a.VectorImage != nil:
+			return a.VectorImage
+		case a.DetailData.VectorImage != nil:
+			return a.DetailData.VectorImage
+		case a.DetailData.NonEntityProfilePicture.VectorImage != nil:
+			return a.DetailData.NonEntityProfilePicture.VectorImage
+		}
+	}
+	return nil
+}
+
+// miniProfile is the compact member entity used in feed actors.
+type miniProfile struct {
+	EntityURN        string `json:"entityUrn"`
+	PublicIdentifier string `json:"publicIdentifier"`
+	FirstName        string `json:"firstName"`
+	LastName         string `json:"lastName"`
+	Occupation       string `json:"occupation"`
+}
+
+// miniCompany is the compact company entity used in feed actors.
+type miniCompany struct {
+	EntityURN     string `json:"entityUrn"`
+	UniversalName string `json:"universalName"`
+	Name          string `json:"name"`
+}
+
+// updateEntity is a feed update in either the legacy or the dash format.
+type updateEntity struct {
+	EntityURN      string `json:"entityUrn"`
+	UpdateMetadata struct {
+		URN string `json:"urn"`
+	} `json:"updateMetadata"`
+	Header struct {
+		Text textView `json:"text"`
+	} `json:"header"`
+	Actor struct {
+		URN               string            `json:"urn"`
+		Name              textView          `json:"name"`
+		Description       textView          `json:"description"`
+		SubDescription    textView          `json:"subDescription"`
+		NavigationContext navigationContext `json:"navigationContext"`
+		Image             imageView         `json:"image"`
+	} `json:"actor"`
+	Commentary struct {
+		Text textView `json:"text"`
+	} `json:"commentary"`
+	SocialDetail      *socialDetail   `json:"socialDetail"`
+	SocialDetailRef   string          `json:"*socialDetail"`
+	Content           updateContent   `json:"content"`
+	ResharedUpdate    json.RawMessage `json:"resharedUpdate"`
+	ResharedUpdateRef string          `json:"*resharedUpdate"`
+	CreatedAt         int64           `json:"createdAt"`
+}
+
+// socialDetail carries an update's engagement counts, inline or by
+// reference.
+type socialDetail struct {
+	URN                          string                `json:"urn"`
+	TotalSocialActivityCounts    *socialActivityCounts `json:"totalSocialActivityCounts"`
+	TotalSocialActivityCountsRef string                `json:"*totalSocialActivityCounts"`
+}
+
+// updateContent is the attachment of an update. The dash format uses short
+// keys; the legacy format uses fully qualified component names.
+type updateContent struct {
+	PollComponent *pollComponent `json:"pollComponent"`
+
+	ImageComponent       *imageComponent `json:"imageComponent"`
+	LegacyImageComponent *imageComponent `json:"com.linkedin.voyager.feed.render.ImageComponent"`
+
+	ArticleComponent       *articleComponent `json:"articleComponent"`
+	LegacyArticleComponent *articleComponent `json:"com.linkedin.voyager.feed.render.ArticleComponent"`
+
+	VideoComponent       *videoComponent `json:"linkedInVideoComponent"`
+	LegacyVideoComponent *videoComponent `json:"com.linkedin.voyager.feed.render.LinkedInVideoComponent"`
+
+	DocumentComponent       *documentComponent `json:"documentComponent"`
+	LegacyDocumentComponent *documentComponent `json:"com.linkedin.voyager.feed.render.DocumentComponent"`
+}
+
+type imageComponent struct {
+	Images []imageView `json:"images"`
+}
+
+type articleComponent struct {
+	NavigationContext navigationContext `json:"navigationContext"`
+	Title             textView          `json:"title"`
+	Subtitle          textView          `json:"subtitle"`
+	Description       textView          `json:"description"`
+	LargeImage        *imageView        `json:"largeImage"`
+	SmallImage        *imageView        `json:"smallImage"`
+}
+
+type videoComponent struct {
+	VideoPlayMetadata struct {
+		ProgressiveStreams []struct {
+			Width              int `json:"width"`
+			Height             int `json:"height"`
+			StreamingLocations []struct {
+				URL string `json:"url"`
+			} `json:"streamingLocations"`
+		} `json:"progressiveStreams"`
+		Thumbnail *vectorImage `json:"thumbnail"`
+	} `json:"videoPlayMetadata"`
+}
+
+type documentComponent struct {
+	Document struct {
+		Title                  string `json:"title"`
+		TranscribedDocumentURL string `json:"transcribedDocumentUrl"`
+		ManifestURL            string `json:"manifestUrl"`
+	} `json:"document"`
+}
+
+// firstNonNil returns a if it is set, otherwise b.
+func firstNonNil[T any](a, b *T) *T {
+	if a != nil {
+		return a
+	}
+	return b
+}
+
+// empty reports whether the update has no attachment.
+func (c *updateContent) empty() bool {
+	return c.PollComponent == nil &&
+		c.ImageComponent == nil && c.LegacyImageComponent == nil &&
+		c.ArticleComponent == nil && c.LegacyArticleComponent == nil &&
+		c.VideoComponent == nil && c.LegacyVideoComponent == nil &&
+		c.DocumentComponent == nil && c.LegacyDocumentComponent == nil
+}
+
+// media returns the images, video or document attached to an update.
+func (c *updateContent) media() []Media {
+	var media []Media
+
+	if ic := firstNonNil(c.ImageComponent, c.LegacyImageComponent); ic != nil {
+		for i := range ic.Images {
+			if u, w, h := ic.Images[i].vector().largest(); u != "" {
+				media = append(media, Media{Type: MediaImage, URL: u, Width: w, Height: h})
+			}
+		}
+	}
+
+	if vc := firstNonNil(c.VideoComponent, c.LegacyVideoComponent); vc != nil {
+		m := Media{Type: MediaVideo}
+		for _, s := range vc.VideoPlayMetadata.ProgressiveStreams {
+			if len(s.StreamingLocations) > 0 && s.Width >= m.Width {
+				m.URL, m.Width, m.Height = s.StreamingLocations[0].URL, s.Width, s.Height
+			}
+		}
+		m.ThumbnailURL, _, _ = vc.VideoPlayMetadata.Thumbnail.largest()
+		if m.URL != "" || m.ThumbnailURL != "" {
+			media = append(media, m)
+		}
+	}
+
+	if dc := firstNonNil(c.DocumentComponent, c.LegacyDocumentComponent); dc != nil {
+		u := dc.Document.TranscribedDocumentURL
+		if u == "" {
+			u = dc.Document.ManifestURL
+		}
+		media = append(media, Media{Type: MediaDocument, URL: u, Title: dc.Document.Title})
+	}
+
+	return media
+}
+
+// article returns the link card attached to an update, or nil.
+func (c *updateContent) article() *Article {
+	ac := firstNonNil(c.ArticleComponent, c.LegacyArticleComponent)
+	if ac == nil {
+		return nil
+	}
+	a := &Article{
+		URL:         ac.NavigationContext.ActionTarget,
+		Title:       ac.Title.Text,
+		Subtitle:    ac.Subtitle.Text,
+		Description: ac.Description.Text,
+	}
+	a.ImageURL, _, _ = firstNonNil(ac.LargeImage, ac.SmallImage).vector().largest()
+	return a
+}
+
+// parseFeedFromResponse extracts feed items from a Voyager response.
+// Updates that only appear as another update's reshared content are not
+// returned separately.
+func parseFeedFromResponse(resp *VoyagerResponse) ([]FeedItem, error) {
+	if resp == nil {
+		return nil, &Error{
+			Code:    ErrCodeServerError,
+			Message: "empty response",
+		}
+	}
+
+	index := newEntityIndex(resp.Included)
+
+	var updates []json.RawMessage
+	reshared := map[string]bool{}
+	for _, raw := range resp.Included {
+		var entity struct {
+			Type              string `json:"$type"`
+			ResharedUpdateRef string `json:"*resharedUpdate"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil {
+			continue
+		}
+		if !strings.Contains(entity.Type, "Update") && !strings.Contains(entity.Type, "Activity") {
+			continue
+		}
+		if entity.ResharedUpdateRef != "" {
+			reshared[entity.ResharedUpdateRef] = true
+		}
+		updates = append(updates, raw)
+	}
+
+	var items []FeedItem
+	for _, raw := range updates {
+		item, err := decodeUpdate(raw, index, 0)
+		if err != nil || reshared[item.URN] {
+			continue
+		}
+		// Skip entities such as activity counts that match the type filter
+		// but are not updates.
+		if item.Post == nil && item.Actor == nil {
+			continue
+		}
+		items = append(items, *item)
+	}
+
+	return items, nil
+}
+
+// parseFeedItem parses a single, self-contained feed item.
+func parseFeedItem(data json.RawMessage) (*FeedItem, error) {
+	return decodeUpdate(data, nil, 0)
+}
+
+// decodeUpdate decodes a feed update, following references through index.
+func decodeUpdate(data json.RawMessage, index entityIndex, depth int) (*FeedItem, error) {
+	var entity updateEntity
+	if err := json.Unmarshal(data, &entity); err != nil {
+		return nil, err
+	}
+
+	itemURN := entity.EntityURN
+	if itemURN == "" {
+		itemURN = entity.UpdateMetadata.URN
+	}
+	if itemURN == "" {
+		return nil, fmt.Errorf("no URN in feed item")
+	}
+
+	item := &FeedItem{
+		URN:    itemURN,
+		Type:   FeedItemPost,
+		Actor:  entity.actor(index),
+		Reason: entity.Header.Text.Text,
+		Promoted: strings.HasPrefix(entity.Actor.SubDescription.Text, "Promoted") ||
+			strings.Contains(strings.ToLower(itemURN), "sponsored"),
+	}
+	if entity.CreatedAt > 0 {
+		item.CreatedAt = time.UnixMilli(entity.CreatedAt)
+	} else if item.CreatedAt = activityTime(itemURN); item.CreatedAt.IsZero() {
+		item.CreatedAt = activityTime(entity.UpdateMetadata.URN)
+	}
+
+	var resharedItem *FeedItem
+	if depth < maxReshareDepth {
+		raw := entity.ResharedUpdate
+		if len(raw) == 0 || string(raw) == "null" {
+			raw = index[entity.ResharedUpdateRef]
+		}
+		if len(raw) > 0 && string(raw) != "null" {
+			resharedItem, _ = decodeUpdate(raw, index, depth+1)
+		}
+	}
+
+	if entity.Commentary.Text.Text == "" && entity.Content.empty() && resharedItem == nil {
+		return item, nil
+	}
+
+	counts := entity.counts(index)
+	post := &Post{
+		URN:          itemURN,
+		Text:         entity.Commentary.Text.Text,
+		CreatedAt:    item.CreatedAt,
+		LikeCount:    counts.likes(),
+		CommentCount: counts.NumComments,
+		ShareCount:   counts.NumShares,
+		Media:        entity.Content.media(),
+		Article:      entity.Content.article(),
+	}
+	if activity := activityURN(itemURN); activity != "" {
+		post.URN = activity
+	} else if activity := activityURN(entity.UpdateMetadata.URN); activity != "" {
+		post.URN = activity
+	}
+	if item.Actor != nil {
+		post.AuthorURN = item.Actor.URN
+		post.AuthorName = item.Actor.Name
+	}
+	if entity.Content.PollComponent != nil {
+		post.Poll = entity.Content.PollComponent.toPoll()
+	}
+	if resharedItem != nil && resharedItem.Post != nil {
+		item.Type = FeedItemReshare
+		post.Reshared = resharedItem.Post
+		post.ResharedURN = resharedItem.Post.URN
+	}
+
+	item.Post = post
+	return item, nil
+}
+
+// counts returns the update's engagement counts.
+func (e *updateEntity) counts(index entityIndex) socialActivityCounts {
+	sd := e.SocialDetail
+	if sd == nil {
+		sd = &socialDetail{}
+		if !index.decode(e.SocialDetailRef, sd) {
+			return socialActivityCounts{}
+		}
+	}
+	if sd.TotalSocialActivityCounts != nil {
+		return *sd.TotalSocialActivityCounts
+	}
+	var counts socialActivityCounts
+	index.decode(sd.TotalSocialActivityCountsRef, &counts)
+	return counts
+}
+
+// actor returns the member or company an update is from, or nil.
+func (e *updateEntity) actor(index entityIndex) *Actor {
+	a := &Actor{
+		URN:      e.Actor.URN,
+		Kind:     ActorMember,
+		Name:     e.Actor.Name.Text,
+		Headline: e.Actor.Description.Text,
+		URL:      stripQuery(e.Actor.NavigationContext.ActionTarget),
+	}
+	a.ImageURL, _, _ = e.Actor.Image.vector().largest()
+
+	for _, attr := range e.Actor.Image.Attributes {
+		mp, mc := attr.MiniProfile, attr.MiniCompany
+		if mp == nil && attr.MiniProfileRef != "" {
+			mp = &miniProfile{}
+			if !index.decode(attr.MiniProfileRef, mp) {
+				mp = &miniProfile{EntityURN: attr.MiniProfileRef}
+			}
+		}
+		if mc == nil && attr.MiniCompanyRef != "" {
+			mc = &miniCompany{}
+			if !index.decode(attr.MiniCompanyRef, mc) {
+				mc = &miniCompany{EntityURN: attr.MiniCompanyRef}
+			}
+		}
+
+		switch {
+		case mp != nil:
+			if a.URN == "" {
+				a.URN = mp.EntityURN
+			}
+			if a.Name == "" {
+				a.Name = strings.TrimSpace(mp.FirstName + " " + mp.LastName)
+			}
+			if a.Headline == "" {
+				a.Headline = mp.Occupation
+			}
+			if a.URL == "" && mp.PublicIdentifier != "" {
+				a.URL = "https://www.linkedin.com/in/" + mp.PublicIdentifier
+			}
+		case mc != nil:
+			a.Kind = ActorCompany
+			if a.URN == "" {
+				a.URN = mc.EntityURN
+			}
+			if a.Name == "" {
+				a.Name = mc.Name
+			}
+			if a.URL == "" && mc.UniversalName != "" {
+				a.URL = "https://www.linkedin.com/company/" + mc.UniversalName
+			}
+		default:
+			continue
+		}
+		break
+	}
+
+	if u, err := urn.Parse(a.URN); err == nil && u.Kind() == urn.KindCompany {
+		a.Kind = ActorCompany
+	}
+	if a.URN == "" && a.Name == "" {
+		return nil
+	}
+	return a
+}
+
+// stripQuery removes tracking parameters from a LinkedIn link.
+func stripQuery(link string) string {
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return link
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
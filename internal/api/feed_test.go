@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseFeedItemRich(t *testing.T) {
+	jsonData := `{
+		"entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,MAIN_FEED,EMPTY,DEFAULT,false)",
+		"header": {"text": {"text": "Jane Doe likes this"}},
+		"actor": {
+			"urn": "urn:li:member:42",
+			"name": {"text": "John Smith"},
+			"description": {"text": "Engineer at Acme"},
+			"navigationContext": {"actionTarget": "https://www.linkedin.com/in/johnsmith?miniProfileUrn=x"},
+			"image": {"attributes": [{
+				"miniProfile": {"entityUrn": "urn:li:fs_miniProfile:ACoAAA", "publicIdentifier": "johnsmith"}
+			}]}
+		},
+		"commentary": {"text": {"text": "Worth reading"}},
+		"socialDetail": {"totalSocialActivityCounts": {"numLikes": 5, "numComments": 2, "numShares": 1}},
+		"content": {
+			"com.linkedin.voyager.feed.render.ArticleComponent": {
+				"navigationContext": {"actionTarget": "https://example.com/story"},
+				"title": {"text": "A story"},
+				"subtitle": {"text": "example.com"},
+				"largeImage": {"attributes": [{"vectorImage": {
+					"rootUrl": "https://media.licdn.com/img/",
+					"artifacts": [
+						{"width": 100, "height": 50, "fileIdentifyingUrlPathSegment": "small.jpg"},
+						{"width": 800, "height": 400, "fileIdentifyingUrlPathSegment": "large.jpg"}
+					]
+				}}]}
+			}
+		},
+		"resharedUpdate": {
+			"entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7000000000000000000,MAIN_FEED,EMPTY,DEFAULT,false)",
+			"actor": {
+				"urn": "urn:li:company:99",
+				"name": {"text": "Acme"},
+				"image": {"attributes": [{"miniCompany": {"universalName": "acme"}}]}
+			},
+			"commentary": {"text": {"text": "Our launch"}},
+			"content": {
+				"imageComponent": {"images": [{"attributes": [{"detailData": {"vectorImage": {
+					"rootUrl": "https://media.licdn.com/launch/",
+					"artifacts": [{"width": 1200, "height": 627, "fileIdentifyingUrlPathSegment": "a.png"}]
+				}}}]}]}
+			}
+		}
+	}`
+
+	item, err := parseFeedItem(json.RawMessage(jsonData))
+	if err != nil {
+		t.Fatalf("parseFeedItem error: %v", err)
+	}
+
+	if item.Type != FeedItemReshare {
+		t.Errorf("Type = %q, want %q", item.Type, FeedItemReshare)
+	}
+	if item.Promoted {
+		t.Error("unexpected promoted item")
+	}
+	if item.Reason != "Jane Doe likes this" {
+		t.Errorf("Reason = %q", item.Reason)
+	}
+	if item.CreatedAt.UnixMilli() != 1698364445927 {
+		t.Errorf("CreatedAt = %v", item.CreatedAt)
+	}
+
+	actor := item.Actor
+	if actor == nil || actor.Kind != ActorMember || actor.Name != "John Smith" || actor.Headline != "Engineer at Acme" {
+		t.Fatalf("Actor = %+v", actor)
+	}
+	if actor.URN != "urn:li:member:42" || actor.URL != "https://www.linkedin.com/in/johnsmith" {
+		t.Errorf("Actor URN/URL = %q, %q", actor.URN, actor.URL)
+	}
+
+	post := item.Post
+	if post == nil {
+		t.Fatal("expected post")
+	}
+	if post.URN != "urn:li:activity:7123456789012345678" || post.AuthorName != "John Smith" {
+		t.Errorf("post = %+v", post)
+	}
+	if post.LikeCount != 5 || post.CommentCount != 2 || post.ShareCount != 1 {
+		t.Errorf("counts = %d/%d/%d, want 5/2/1", post.LikeCount, post.CommentCount, post.ShareCount)
+	}
+	if post.Article == nil || post.Article.URL != "https://example.com/story" || post.Article.Title != "A story" {
+		t.Fatalf("Article = %+v", post.Article)
+	}
+	if post.Article.ImageURL != "https://media.licdn.com/img/large.jpg" {
+		t.Errorf("Article.ImageURL = %q", post.Article.ImageURL)
+	}
+
+	reshared := post.Reshared
+	if reshared == nil || post.ResharedURN != "urn:li:activity:7000000000000000000" {
+		t.Fatalf("Reshared = %+v, ResharedURN = %q", reshared, post.ResharedURN)
+	}
+	if reshared.AuthorURN != "urn:li:company:99" || reshared.Text != "Our launch" {
+		t.Errorf("reshared = %+v", reshared)
+	}
+	if len(reshared.Media) != 1 || reshared.Media[0].Type != MediaImage || reshared.Media[0].URL != "https://media.licdn.com/launch/a.png" {
+		t.Errorf("reshared Media = %+v", reshared.Media)
+	}
+}
+
+func TestParseFeedFromResponseNormalized(t *testing.T) {
+	resp := &VoyagerResponse{Included: []json.RawMessage{
+		json.RawMessage(`{
+			"$type": "com.linkedin.voyager.feed.render.UpdateV2",
+			"entityUrn": "urn:li:fs_updateV2:(urn:li:activity:2,MAIN_FEED)",
+			"actor": {"name": {"text": "Acme"}, "subDescription": {"text": "Promoted"}, "image": {"attributes": [{"*miniCompany": "urn:li:fs_miniCompany:99"}]}},
+			"commentary": {"text": {"text": "Look at this"}},
+			"*socialDetail": "urn:li:fs_socialDetail:2",
+			"*resharedUpdate": "urn:li:fs_updateV2:(urn:li:activity:1,MAIN_FEED)"
+		}`),
+		json.RawMessage(`{
+			"$type": "com.linkedin.voyager.feed.render.UpdateV2",
+			"entityUrn": "urn:li:fs_updateV2:(urn:li:activity:1,MAIN_FEED)",
+			"actor": {"name": {"text": "Jane Doe"}},
+			"commentary": {"text": {"text": "Original"}},
+			"content": {"documentComponent": {"document": {"title": "Deck", "transcribedDocumentUrl": "https://media.licdn.com/doc.pdf"}}}
+		}`),
+		json.RawMessage(`{
+			"$type": "com.linkedin.voyager.feed.SocialDetail",
+			"entityUrn": "urn:li:fs_socialDetail:2",
+			"*totalSocialActivityCounts": "urn:li:fs_socialActivityCounts:2"
+		}`),
+		json.RawMessage(`{
+			"$type": "com.linkedin.voyager.feed.shared.SocialActivityCounts",
+			"entityUrn": "urn:li:fs_socialActivityCounts:2",
+			"numLikes": 12,
+			"numComments": 3
+		}`),
+		json.RawMessage(`{
+			"$type": "com.linkedin.voyager.entities.shared.MiniCompany",
+			"entityUrn": "urn:li:fs_miniCompany:99",
+			"universalName": "acme"
+		}`),
+	}}
+
+	items, err := parseFeedFromResponse(resp)
+	if err != nil {
+		t.Fatalf("parseFeedFromResponse error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, want 1: %+v", len(items), items)
+	}
+
+	item := items[0]
+	if !item.Promoted {
+		t.Error("expected promoted item")
+	}
+	if item.Actor == nil || item.Actor.Kind != ActorCompany || item.Actor.URL != "https://www.linkedin.com/company/acme" {
+		t.Errorf("Actor = %+v", item.Actor)
+	}
+	if item.Post.LikeCount != 12 || item.Post.CommentCount != 3 {
+		t.Errorf("counts = %d/%d, want 12/3", item.Post.LikeCount, item.Post.CommentCount)
+	}
+	reshared := item.Post.Reshared
+	if reshared == nil || reshared.Text != "Original" || reshared.AuthorName != "Jane Doe" {
+		t.Fatalf("Reshared = %+v", reshared)
+	}
+	if len(reshared.Media) != 1 || reshared.Media[0].Type != MediaDocument || reshared.Media[0].Title != "Deck" {
+		t.Errorf("reshared Media = %+v", reshared.Media)
+	}
+}
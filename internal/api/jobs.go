@@ -0,0 +1,370 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/urn"
+)
+
+// jobsPageSize is the page size used when paging job search results.
+const jobsPageSize = 25
+
+// worldwideGeoID is the geo LinkedIn searches when no location is given.
+const worldwideGeoID = "92000000"
+
+// JobSearchOptions configures a job search. Location and Companies may be
+// names, which are looked up through typeahead, or URNs and numeric IDs.
+type JobSearchOptions struct {
+	Limit        int           `json:"limit,omitempty"`
+	Start        int           `json:"start,omitempty"`
+	Location     string        `json:"location,omitempty"`
+	Remote       bool          `json:"remote,omitempty"`
+	Experience   []string      `json:"experience,omitempty"`
+	PostedWithin time.Duration `json:"postedWithin,omitempty"`
+	Companies    []string      `json:"companies,omitempty"`
+}
+
+// experienceLevels maps experience level names to LinkedIn filter values.
+var experienceLevels = map[string]string{
+	"internship":  "1",
+	"intern":      "1",
+	"entry":       "2",
+	"entry-level": "2",
+	"associate":   "3",
+	"mid-senior":  "4",
+	"mid":         "4",
+	"senior":      "4",
+	"director":    "5",
+	"executive":   "6",
+}
+
+// ParseExperience converts an experience level such as "entry",
+// "mid-senior" or "director" into a job search filter value.
+func ParseExperience(s string) (string, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if v, ok := experienceLevels[s]; ok {
+		return v, nil
+	}
+	if len(s) == 1 && s >= "1" && s <= "6" {
+		return s, nil
+	}
+	return "", fmt.Errorf("invalid experience level %q: use internship, entry, associate, mid-senior, director or executive", s)
+}
+
+// SearchJobs searches job postings, paging until opts.Limit jobs are
+// collected or there are no more.
+func (c *Client) SearchJobs(ctx context.Context, keywords string, opts *JobSearchOptions) ([]Job, error) {
+	if opts == nil {
+		opts = &JobSearchOptions{Limit: 10}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+
+	geoID := worldwideGeoID
+	if opts.Location != "" {
+		id, err := c.resolveFilterID(ctx, TypeaheadGeo, opts.Location)
+		if err != nil {
+			return nil, err
+		}
+		geoID = id
+	}
+
+	selected := make(map[string][]string)
+	for _, e := range opts.Experience {
+		v, err := ParseExperience(e)
+		if err != nil {
+			return nil, &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+		}
+		selected["experience"] = append(selected["experience"], v)
+	}
+	for _, name := range opts.Companies {
+		id, err := c.resolveFilterID(ctx, TypeaheadCompany, name)
+		if err != nil {
+			return nil, err
+		}
+		selected["company"] = append(selected["company"], id)
+	}
+	if opts.Remote {
+		selected["workplaceType"] = []string{"2"}
+	}
+	if opts.PostedWithin > 0 {
+		selected["timePostedRange"] = []string{fmt.Sprintf("r%d", int64(opts.PostedWithin.Seconds()))}
+	}
+
+	jobs := []Job{}
+	seen := make(map[string]bool)
+	start := opts.Start
+	for len(jobs) < opts.Limit {
+		count := min(jobsPageSize, opts.Limit-len(jobs))
+
+		var result VoyagerResponse
+		if err := c.Get(ctx, buildJobSearchPath(keywords, geoID, selected, start, count), nil, &result); err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, job := range parseJobCards(result.Included) {
+			if seen[job.URN] {
+				continue
+			}
+			seen[job.URN] = true
+			jobs = append(jobs, job)
+			added++
+			if len(jobs) == opts.Limit {
+				break
+			}
+		}
+
+		if added == 0 || (result.Paging != nil && result.Paging.Total > 0 && start+count >= result.Paging.Total) {
+			break
+		}
+		start += count
+	}
+
+	return jobs, nil
+}
+
+// buildJobSearchPath constructs the job cards search path. Filters are
+// sorted by key so the path is deterministic.
+func buildJobSearchPath(keywords, geoID string, selected map[string][]string, start, count int) string {
+	keys := make([]string, 0, len(selected))
+	for k := range selected {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	filters := make([]string, 0, len(keys))
+	for _, k := range keys {
+		filters = append(filters, fmt.Sprintf("%s:List(%s)", k, strings.Join(selected[k], ",")))
+	}
+
+	return fmt.Sprintf(
+		"/voyagerJobsDashJobCards?decorationId=com.linkedin.voyager.dash.deco.jobs.search.JobSearchCardsCollection-187&count=%d&q=jobSearch&query=(origin:JOB_SEARCH_PAGE_OTHER_ENTRY,keywords:%s,locationUnion:(geoId:%s),selectedFilters:(%s),spellCorrectionEnabled:true)&start=%d",
+		count,
+		url.QueryEscape(keywords),
+		geoID,
+		strings.Join(filters, ","),
+		start,
+	)
+}
+
+// jobPostingCard is a job search result card.
+type jobPostingCard struct {
+	Type                 string    `json:"$type"`
+	JobPostingURN        string    `json:"jobPostingUrn"`
+	Title                *textView `json:"title"`
+	JobPostingTitle      string    `json:"jobPostingTitle"`
+	PrimaryDescription   *textView `json:"primaryDescription"`
+	SecondaryDescription *textView `json:"secondaryDescription"`
+	FooterItems          []struct {
+		Type   string `json:"type"`
+		TimeAt int64  `json:"timeAt"`
+	} `json:"footerItems"`
+}
+
+// parseJobCards extracts jobs from the job cards of a search response, in
+// the order they appear.
+func parseJobCards(included []json.RawMessage) []Job {
+	var jobs []Job
+	for _, raw := range included {
+		var card jobPostingCard
+		if err := json.Unmarshal(raw, &card); err != nil {
+			continue
+		}
+		if !strings.HasSuffix(card.Type, ".JobPostingCard") || card.JobPostingURN == "" {
+			continue
+		}
+
+		job := Job{URN: card.JobPostingURN, Title: card.JobPostingTitle}
+		if card.Title != nil && card.Title.Text != "" {
+			job.Title = card.Title.Text
+		}
+		if card.PrimaryDescription != nil {
+			job.CompanyName = card.PrimaryDescription.Text
+		}
+		if card.SecondaryDescription != nil {
+			job.Location = card.SecondaryDescription.Text
+			job.WorkplaceType = workplaceFromLocation(job.Location)
+		}
+		for _, f := range card.FooterItems {
+			if f.Type == "LISTED_DATE" && f.TimeAt > 0 {
+				job.PostedAt = time.UnixMilli(f.TimeAt)
+			}
+		}
+		if id := jobID(job.URN); id != "" {
+			job.URL = "https://www.linkedin.com/jobs/view/" + id + "/"
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+// workplaceFromLocation reads the workplace type from a location such as
+// "Berlin, Germany (Hybrid)", or returns "".
+func workplaceFromLocation(location string) string {
+	switch {
+	case strings.HasSuffix(location, "(Remote)"):
+		return WorkplaceRemote
+	case strings.HasSuffix(location, "(Hybrid)"):
+		return WorkplaceHybrid
+	case strings.HasSuffix(location, "(On-site)"):
+		return WorkplaceOnSite
+	default:
+		return ""
+	}
+}
+
+// workplaceTypes maps workplace type URN IDs to workplace types.
+var workplaceTypes = map[string]string{
+	"1": WorkplaceOnSite,
+	"2": WorkplaceRemote,
+	"3": WorkplaceHybrid,
+}
+
+// jobID returns the numeric ID of a job posting URN, or "".
+func jobID(s string) string {
+	u, err := urn.Parse(s)
+	if err != nil || !reNumericID.MatchString(u.ID) {
+		return ""
+	}
+	return u.ID
+}
+
+// ParseJobID returns the numeric ID of a job given as an ID, URN or job URL.
+func ParseJobID(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if reNumericID.MatchString(s) {
+		return s, nil
+	}
+	ref, err := ParseURN(s, RefJob)
+	if err != nil {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+	}
+	if id := jobID(ref); id != "" {
+		return id, nil
+	}
+	return "", &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("invalid job: %q", s)}
+}
+
+// jobPostingEntity is the full job posting returned by the job details
+// endpoint.
+type jobPostingEntity struct {
+	EntityURN         string    `json:"entityUrn"`
+	DashEntityURN     string    `json:"dashEntityUrn"`
+	Title             string    `json:"title"`
+	Description       *textView `json:"description"`
+	FormattedLocation string    `json:"formattedLocation"`
+	ListedAt          int64     `json:"listedAt"`
+	OriginalListedAt  int64     `json:"originalListedAt"`
+	Applies           int       `json:"applies"`
+	WorkRemoteAllowed bool      `json:"workRemoteAllowed"`
+	WorkplaceTypes    []string  `json:"workplaceTypes"`
+	JobPostingURL     string    `json:"jobPostingUrl"`
+	ApplyMethod       map[string]struct {
+		CompanyApplyURL string `json:"companyApplyUrl"`
+		EasyApplyURL    string `json:"easyApplyUrl"`
+	} `json:"applyMethod"`
+	CompanyDetails map[string]struct {
+		Company                 string       `json:"company"`
+		CompanyResolutionResult *miniCompany `json:"companyResolutionResult"`
+		CompanyRef              string       `json:"*companyResolutionResult"`
+		CompanyName             string       `json:"companyName"`
+	} `json:"companyDetails"`
+}
+
+// GetJob fetches a job posting's details by ID, URN or URL.
+func (c *Client) GetJob(ctx context.Context, job string) (*Job, error) {
+	id, err := ParseJobID(job)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"decorationId": {"com.linkedin.voyager.deco.jobs.web.shared.WebFullJobPosting-65"},
+	}
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/jobs/jobPostings/"+id, query, &result); err != nil {
+		return nil, err
+	}
+
+	return parseJobPosting(id, &result)
+}
+
+// parseJobPosting decodes a job details response.
+func parseJobPosting(id string, result *VoyagerResponse) (*Job, error) {
+	var entity jobPostingEntity
+	if err := json.Unmarshal(result.Data, &entity); err != nil || entity.Title == "" {
+		return nil, &Error{Code: ErrCodeNotFound, Message: "job not found"}
+	}
+	index := newEntityIndex(result.Included)
+
+	job := &Job{
+		URN:            "urn:li:fsd_jobPosting:" + id,
+		Title:          entity.Title,
+		Location:       entity.FormattedLocation,
+		ApplicantCount: entity.Applies,
+		URL:            "https://www.linkedin.com/jobs/view/" + id + "/",
+	}
+	if entity.Description != nil {
+		job.Description = entity.Description.Text
+	}
+
+	listed := entity.ListedAt
+	if listed == 0 {
+		listed = entity.OriginalListedAt
+	}
+	if listed > 0 {
+		job.PostedAt = time.UnixMilli(listed)
+	}
+
+	for _, w := range entity.WorkplaceTypes {
+		if u, err := urn.Parse(w); err == nil {
+			if t, ok := workplaceTypes[u.ID]; ok {
+				job.WorkplaceType = t
+				break
+			}
+		}
+	}
+	if job.WorkplaceType == "" && entity.WorkRemoteAllowed {
+		job.WorkplaceType = WorkplaceRemote
+	}
+
+	for kind, m := range entity.ApplyMethod {
+		switch {
+		case m.CompanyApplyURL != "":
+			job.ApplyURL = m.CompanyApplyURL
+		case m.EasyApplyURL != "" || strings.Contains(kind, "OnsiteApply"):
+			job.EasyApply = true
+			job.ApplyURL = job.URL
+		}
+	}
+
+	for _, d := range entity.CompanyDetails {
+		company := d.CompanyResolutionResult
+		if company == nil && d.CompanyRef != "" {
+			var mc miniCompany
+			if index.decode(d.CompanyRef, &mc) {
+				company = &mc
+			}
+		}
+		job.CompanyURN = d.Company
+		switch {
+		case company != nil:
+			job.CompanyName = company.Name
+			if job.CompanyURN == "" {
+				job.CompanyURN = company.EntityURN
+			}
+		case d.CompanyName != "":
+			job.CompanyName = d.CompanyName
+		}
+	}
+
+	return job, nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildJobSearchPath(t *testing.T) {
+	path := buildJobSearchPath("go engineer", "103035651", map[string][]string{
+		"workplaceType":   {"2"},
+		"experience":      {"2", "4"},
+		"timePostedRange": {"r604800"},
+	}, 25, 10)
+
+	want := "query=(origin:JOB_SEARCH_PAGE_OTHER_ENTRY,keywords:go+engineer,locationUnion:(geoId:103035651)," +
+		"selectedFilters:(experience:List(2,4),timePostedRange:List(r604800),workplaceType:List(2)),spellCorrectionEnabled:true)&start=25"
+	if !strings.Contains(path, want) || !strings.Contains(path, "count=10") {
+		t.Errorf("path = %s", path)
+	}
+}
+
+func TestParseExperience(t *testing.T) {
+	for in, want := range map[string]string{"entry": "2", "Mid-Senior": "4", "director": "5", "6": "6"} {
+		if got, err := ParseExperience(in); err != nil || got != want {
+			t.Errorf("ParseExperience(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseExperience("guru"); err == nil {
+		t.Error("ParseExperience(guru) should fail")
+	}
+}
+
+func TestParseJobID(t *testing.T) {
+	tests := map[string]string{
+		"3812345678":                                                    "3812345678",
+		"urn:li:fsd_jobPosting:3812345678":                              "3812345678",
+		"https://www.linkedin.com/jobs/view/3812345678/":                "3812345678",
+		"https://www.linkedin.com/jobs/search/?currentJobId=3812345678": "3812345678",
+	}
+	for in, want := range tests {
+		if got, err := ParseJobID(in); err != nil || got != want {
+			t.Errorf("ParseJobID(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseJobID("https://www.linkedin.com/in/jane"); err == nil {
+		t.Error("ParseJobID(profile URL) should fail")
+	}
+}
+
+func TestSearchJobs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/typeahead/hitsV2" {
+			_, _ = w.Write([]byte(`{"data": {"elements": [{"targetUrn": "urn:li:fs_geo:103035651"}]}}`))
+			return
+		}
+		if !strings.Contains(r.URL.RawQuery, "locationUnion:(geoId:103035651)") ||
+			!strings.Contains(r.URL.RawQuery, "workplaceType:List(2)") {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		if !strings.Contains(r.URL.RawQuery, "start=0") {
+			_, _ = w.Write([]byte(`{"included": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"included": [
+			{"$type": "com.linkedin.voyager.dash.jobs.JobPostingCard", "entityUrn": "urn:li:fsd_jobPostingCard:(1,JOBS_SEARCH)", "jobPostingUrn": "urn:li:fsd_jobPosting:1",
+			 "title": {"text": "Go Engineer"}, "primaryDescription": {"text": "Acme"}, "secondaryDescription": {"text": "Berlin, Germany (Remote)"},
+			 "footerItems": [{"type": "LISTED_DATE", "timeAt": 1760000000000}]},
+			{"$type": "com.linkedin.voyager.dash.jobs.JobPostingCard", "entityUrn": "urn:li:fsd_jobPostingCard:(1,JOB_DETAILS)", "jobPostingUrn": "urn:li:fsd_jobPosting:1", "title": {"text": "Go Engineer"}},
+			{"$type": "com.linkedin.voyager.dash.jobs.JobPosting", "entityUrn": "urn:li:fsd_jobPosting:2", "title": "Ignored"},
+			{"$type": "com.linkedin.voyager.dash.jobs.JobPostingCard", "entityUrn": "urn:li:fsd_jobPostingCard:(2,JOBS_SEARCH)", "jobPostingUrn": "urn:li:fsd_jobPosting:2", "title": {"text": "SRE"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	jobs, err := c.SearchJobs(context.Background(), "go", &JobSearchOptions{Limit: 5, Location: "Berlin", Remote: true})
+	if err != nil {
+		t.Fatalf("SearchJobs() error: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("len(jobs) = %d, want 2: %+v", len(jobs), jobs)
+	}
+	j := jobs[0]
+	if j.Title != "Go Engineer" || j.CompanyName != "Acme" || j.WorkplaceType != WorkplaceRemote ||
+		j.URL != "https://www.linkedin.com/jobs/view/1/" || !j.PostedAt.Equal(time.UnixMilli(1760000000000)) {
+		t.Errorf("jobs[0] = %+v", j)
+	}
+}
+
+func TestParseJobPosting(t *testing.T) {
+	resp := &VoyagerResponse{
+		Data: []byte(`{
+			"entityUrn": "urn:li:fs_normalized_jobPosting:3812345678",
+			"title": "Staff Engineer",
+			"description": {"text": "Build things."},
+			"formattedLocation": "Berlin, Germany",
+			"listedAt": 1760000000000,
+			"applies": 87,
+			"workplaceTypes": ["urn:li:fs_workplaceType:3"],
+			"applyMethod": {"com.linkedin.voyager.jobs.OffsiteApply": {"companyApplyUrl": "https://acme.example/jobs/1"}},
+			"companyDetails": {"com.linkedin.voyager.deco.jobs.web.shared.WebCompactJobPostingCompany": {
+				"company": "urn:li:fs_normalized_company:42",
+				"*companyResolutionResult": "urn:li:fs_normalized_company:42"
+			}}
+		}`),
+		Included: []json.RawMessage{
+			[]byte(`{"entityUrn": "urn:li:fs_normalized_company:42", "name": "Acme"}`),
+		},
+	}
+
+	job, err := parseJobPosting("3812345678", resp)
+	if err != nil {
+		t.Fatalf("parseJobPosting() error: %v", err)
+	}
+	want := Job{
+		URN:            "urn:li:fsd_jobPosting:3812345678",
+		Title:          "Staff Engineer",
+		CompanyName:    "Acme",
+		CompanyURN:     "urn:li:fs_normalized_company:42",
+		Location:       "Berlin, Germany",
+		WorkplaceType:  WorkplaceHybrid,
+		PostedAt:       time.UnixMilli(1760000000000),
+		Description:    "Build things.",
+		ApplicantCount: 87,
+		ApplyURL:       "https://acme.example/jobs/1",
+		URL:            "https://www.linkedin.com/jobs/view/3812345678/",
+	}
+	if *job != want {
+		t.Errorf("job = %+v\nwant  %+v", *job, want)
+	}
+
+	if _, err := parseJobPosting("1", &VoyagerResponse{Data: []byte(`{}`)}); err == nil {
+		t.Error("expected not found for empty posting")
+	}
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Reaction types as LinkedIn names them internally.
+const (
+	ReactionLike       = "LIKE"
+	ReactionCelebrate  = "PRAISE"
+	ReactionSupport    = "APPRECIATION"
+	ReactionLove       = "EMPATHY"
+	ReactionInsightful = "INTEREST"
+	ReactionFunny      = "ENTERTAINMENT"
+)
+
+// reactionNames maps the names shown in LinkedIn's UI to reaction types.
+var reactionNames = map[string]string{
+	"like":       ReactionLike,
+	"celebrate":  ReactionCelebrate,
+	"support":    ReactionSupport,
+	"love":       ReactionLove,
+	"insightful": ReactionInsightful,
+	"funny":      ReactionFunny,
+}
+
+// ParseReactionType converts a UI reaction name such as "celebrate" into a
+// reaction type. Reaction type constants are accepted as-is.
+func ParseReactionType(name string) (string, error) {
+	if t, ok := reactionNames[strings.ToLower(name)]; ok {
+		return t, nil
+	}
+	for _, t := range reactionNames {
+		if strings.EqualFold(name, t) {
+			return t, nil
+		}
+	}
+
+	names := make([]string, 0, len(reactionNames))
+	for n := range reactionNames {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return "", &Error{
+		Code:    ErrCodeInvalidInput,
+		Message: fmt.Sprintf("invalid reaction %q (use %s)", name, strings.Join(names, ", ")),
+	}
+}
+
+// ReactionName returns the UI name for a reaction type, or the type itself
+// if it is unknown.
+func ReactionName(reactionType string) string {
+	for n, t := range reactionNames {
+		if t == reactionType {
+			return n
+		}
+	}
+	return reactionType
+}
+
+// React adds a reaction to a post or comment, replacing any previous
+// reaction by the current member.
+func (c *Client) React(ctx context.Context, urn, reactionType string) error {
+	err := c.Do(ctx, &Request{
+		Method:      http.MethodPost,
+		Path:        "/voyagerSocialDashReactions",
+		Query:       url.Values{"threadUrn": {urn}},
+		Body:        map[string]any{"reactionType": reactionType},
+		RequireAuth: true,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	c.audit(ctx, &Mutation{Operation: OpReact, Target: urn, Content: reactionType})
+	return nil
+}
+
+// Unreact removes the current member's reaction from a post or comment.
+func (c *Client) Unreact(ctx context.Context, urn string) error {
+	err := c.Do(ctx, &Request{
+		Method:      http.MethodDelete,
+		Path:        "/voyagerSocialDashReactions",
+		Query:       url.Values{"threadUrn": {urn}},
+		RequireAuth: true,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	c.audit(ctx, &Mutation{Operation: OpUnreact, Target: urn})
+	return nil
+}
+
+// ReactionOptions configures reaction fetching.
+type ReactionOptions struct {
+	Limit int
+	Start int
+	// Type limits results to one reaction type; empty means all.
+	Type string
+}
+
+// ReactionPage is one page of reactions on a post or comment.
+type ReactionPage struct {
+	Reactions []Reaction
+	// Count is the number of elements the API returned on the page, which
+	// may be more than len(Reactions) when some could not be parsed.
+	Count int
+	// Total is the number of reactions reported by the API, or 0 if unknown.
+	Total int
+}
+
+// More reports whether another page may follow this one, which was fetched
+// with opts. It goes by the paging total when the API reports one and by
+// the raw element count otherwise, never by the parsed reactions.
+func (p *ReactionPage) More(opts *ReactionOptions) bool {
+	if p.Count == 0 {
+		return false
+	}
+	if p.Total > 0 {
+		return opts.Start+opts.Limit < p.Total
+	}
+	return p.Count >= opts.Limit
+}
+
+// GetReactions fetches one page of reactions on a post or comment.
+func (c *Client) GetReactions(ctx context.Context, urn string, opts *ReactionOptions) (*ReactionPage, error) {
+	if opts == nil {
+		opts = &ReactionOptions{Limit: 20}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	query := url.Values{
+		"q":         {"reactionType"},
+		"threadUrn": {urn},
+		"count":     {fmt.Sprintf("%d", opts.Limit)},
+		"start":     {fmt.Sprintf("%d", opts.Start)},
+	}
+	if opts.Type != "" {
+		query.Set("reactionType", opts.Type)
+	}
+
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/voyagerSocialDashReactions", query, &result); err != nil {
+		return nil, err
+	}
+
+	reactions, err := parseReactionsFromResponse(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ReactionPage{Reactions: reactions, Count: countReactionElements(&result)}
+	if result.Paging != nil {
+		page.Total = result.Paging.Total
+	}
+	return page, nil
+}
+
+// countReactionElements returns the number of reactions on a response
+// page: the length of data.*elements in a normalized response, or else the
+// number of included reaction entities, parsed or not.
+func countReactionElements(resp *VoyagerResponse) int {
+	var data struct {
+		Elements []json.RawMessage `json:"*elements"`
+	}
+	if len(resp.Data) > 0 && json.Unmarshal(resp.Data, &data) == nil && data.Elements != nil {
+		return len(data.Elements)
+	}
+
+	n := 0
+	for _, raw := range resp.Included {
+		var entity struct {
+			Type string `json:"$type"`
+		}
+		if json.Unmarshal(raw, &entity) == nil && strings.HasSuffix(entity.Type, ".Reaction") {
+			n++
+		}
+	}
+	return n
+}
+
+// parseReactionsFromResponse extracts reactions from a Voyager response.
+func parseReactionsFromResponse(resp *VoyagerResponse) ([]Reaction, error) {
+	if resp == nil {
+		return nil, &Error{
+			Code:    ErrCodeServerError,
+			Message: "empty response",
+		}
+	}
+
+	profiles := extractProfilesFromIncluded(resp.Included)
+
+	reactions := []Reaction{}
+	for _, raw := range resp.Included {
+		var entity struct {
+			Type          string `json:"$type"`
+			ReactionType  string `json:"reactionType"`
+			ActorURN      string `json:"actorUrn"`
+			ReactorLockup struct {
+				Title struct {
+					Text string `json:"text"`
+				} `json:"title"`
+				Subtitle struct {
+					Text string `json:"text"`
+				} `json:"subtitle"`
+				NavigationURL string `json:"navigationUrl"`
+			} `json:"reactorLockup"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil {
+			continue
+		}
+		if !strings.HasSuffix(entity.Type, ".Reaction") || entity.ReactionType == "" {
+			continue
+		}
+
+		reactor := Profile{URN: entity.ActorURN}
+		if p, ok := profiles[entity.ActorURN]; ok {
+			reactor = *p
+		} else {
+			// Only the display name is known, which cannot be split into
+			// first and last name reliably.
+			reactor.Name = strings.TrimSpace(entity.ReactorLockup.Title.Text)
+			reactor.Headline = entity.ReactorLockup.Subtitle.Text
+			reactor.ProfileURL = entity.ReactorLockup.NavigationURL
+		}
+
+		reactions = append(reactions, Reaction{
+			Type:    entity.ReactionType,
+			Reactor: reactor,
+		})
+	}
+
+	return reactions, nil
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseReactionType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "like", want: ReactionLike},
+		{input: "Celebrate", want: ReactionCelebrate},
+		{input: "funny", want: ReactionFunny},
+		{input: "EMPATHY", want: ReactionLove},
+		{input: "angry", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseReactionType(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseReactionType() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReactionType(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if ReactionName(got) == got {
+				t.Errorf("ReactionName(%q) has no UI name", got)
+			}
+		})
+	}
+}
+
+func TestParseReactionsFromResponse(t *testing.T) {
+	resp := &VoyagerResponse{
+		Included: []json.RawMessage{
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.dash.social.Reaction",
+				"reactionType": "PRAISE",
+				"actorUrn": "urn:li:fsd_profile:A",
+				"reactorLockup": {
+					"title": {"text": "Jane Q Doe"},
+					"subtitle": {"text": "Engineer"},
+					"navigationUrl": "https://www.linkedin.com/in/janedoe"
+				}
+			}`),
+			json.RawMessage(`{
+				"$type": "com.linkedin.voyager.dash.social.ReactionsByType",
+				"reactionType": "LIKE"
+			}`),
+		},
+	}
+
+	reactions, err := parseReactionsFromResponse(resp)
+	if err != nil {
+		t.Fatalf("parseReactionsFromResponse error: %v", err)
+	}
+	if len(reactions) != 1 {
+		t.Fatalf("reactions = %d, want 1", len(reactions))
+	}
+
+	r := reactions[0]
+	if r.Type != ReactionCelebrate {
+		t.Errorf("Type = %q, want %q", r.Type, ReactionCelebrate)
+	}
+	if r.Reactor.Name != "Jane Q Doe" || r.Reactor.FirstName != "" || r.Reactor.LastName != "" || r.Reactor.Headline != "Engineer" {
+		t.Errorf("Reactor = %+v", r.Reactor)
+	}
+}
+
+func TestGetReactionsPagesPastSkippedEntities(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("start")
+		starts = append(starts, start)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch start {
+		case "0":
+			// A full page of two, one of which has no reaction type.
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.dash.social.Reaction", "reactionType": "LIKE", "actorUrn": "urn:li:fsd_profile:A"},
+				{"$type": "com.linkedin.voyager.dash.social.Reaction", "actorUrn": "urn:li:fsd_profile:B"}
+			]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.dash.social.Reaction", "reactionType": "PRAISE", "actorUrn": "urn:li:fsd_profile:C"}
+			]}`))
+		default:
+			t.Errorf("unexpected page %s", start)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	opts := &ReactionOptions{Limit: 2}
+	var reactions []Reaction
+	for {
+		page, err := c.GetReactions(context.Background(), "urn:li:activity:1", opts)
+		if err != nil {
+			t.Fatalf("GetReactions() error: %v", err)
+		}
+		reactions = append(reactions, page.Reactions...)
+		if !page.More(opts) {
+			break
+		}
+		opts.Start += opts.Limit
+	}
+
+	if len(reactions) != 2 || reactions[1].Reactor.URN != "urn:li:fsd_profile:C" {
+		t.Errorf("reactions = %+v", reactions)
+	}
+	if len(starts) != 2 || starts[1] != "2" {
+		t.Errorf("starts = %v, want [0 2]", starts)
+	}
+}
+
+func TestReactionPageMore(t *testing.T) {
+	opts := &ReactionOptions{Limit: 10, Start: 10}
+	tests := []struct {
+		name string
+		page ReactionPage
+		want bool
+	}{
+		{name: "empty page", page: ReactionPage{Total: 50}, want: false},
+		{name: "before total", page: ReactionPage{Count: 10, Total: 25}, want: true},
+		{name: "reaches total", page: ReactionPage{Count: 10, Total: 20}, want: false},
+		{name: "full page without total", page: ReactionPage{Count: 10, Reactions: make([]Reaction, 7)}, want: true},
+		{name: "short page without total", page: ReactionPage{Count: 7}, want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.page.More(opts); got != tt.want {
+			t.Errorf("%s: More() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
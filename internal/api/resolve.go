@@ -0,0 +1,286 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pp/lnk/internal/urn"
+)
+
+// RefKind identifies what a URN or LinkedIn URL points at.
+type RefKind = urn.Kind
+
+// Reference kinds.
+const (
+	RefPost         = urn.KindPost
+	RefComment      = urn.KindComment
+	RefProfile      = urn.KindProfile
+	RefCompany      = urn.KindCompany
+	RefJob          = urn.KindJob
+	RefConversation = urn.KindConversation
+	RefSchool       = urn.KindSchool
+	RefGroup        = urn.KindGroup
+	RefEvent        = urn.KindEvent
+	RefUnknown      = urn.KindUnknown
+)
+
+// Ref is a resolved URN or LinkedIn URL. Profile, company and school URLs
+// that use a vanity name carry it in PublicID and leave URN empty, since turning
+// them into a URN needs an API lookup.
+type Ref struct {
+	Kind     RefKind `json:"kind"`
+	URN      string  `json:"urn,omitempty"`
+	PublicID string  `json:"publicId,omitempty"`
+}
+
+var (
+	rePostInURL    = regexp.MustCompile(`urn:li:(?:activity|share|ugcPost):\d+`)
+	rePostSlug     = regexp.MustCompile(`-(activity|share|ugcPost)-(\d+)`)
+	reNumericID    = regexp.MustCompile(`^\d+$`)
+	reProfileIDURL = regexp.MustCompile(`^ACoA[A-Za-z0-9_-]+$`)
+)
+
+// ParseRef resolves a URN, a LinkedIn URL or a bare activity ID. Supported
+// URLs cover posts (/posts/, /feed/update/), comments (?commentUrn=),
+// profiles (/in/), companies (/company/, /showcase/), schools (/school/),
+// jobs (/jobs/view/ or ?currentJobId=) and messaging threads
+// (/messaging/thread/).
+func ParseRef(s string) (*Ref, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, invalidRef(s)
+	}
+
+	if strings.HasPrefix(s, "urn:") {
+		u, err := urn.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		if a, ok := u.Activity(); ok {
+			u = a
+		}
+		return &Ref{Kind: u.Kind(), URN: u.String()}, nil
+	}
+	if reNumericID.MatchString(s) {
+		return &Ref{Kind: RefPost, URN: "urn:li:activity:" + s}, nil
+	}
+
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil || !isLinkedInHost(u.Host) {
+		return nil, invalidRef(s)
+	}
+
+	query := u.Query()
+	if c := query.Get("commentUrn"); c != "" {
+		return &Ref{Kind: RefComment, URN: c}, nil
+	}
+	if j := query.Get("currentJobId"); reNumericID.MatchString(j) {
+		return &Ref{Kind: RefJob, URN: "urn:li:fsd_jobPosting:" + j}, nil
+	}
+
+	path, _ := url.PathUnescape(u.Path)
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+	if len(segments) == 0 {
+		return nil, invalidRef(s)
+	}
+
+	switch segments[0] {
+	case "posts", "feed", "embed":
+		if m := rePostInURL.FindString(path); m != "" {
+			return &Ref{Kind: RefPost, URN: m}, nil
+		}
+		if m := rePostSlug.FindStringSubmatch(path); m != nil {
+			return &Ref{Kind: RefPost, URN: fmt.Sprintf("urn:li:%s:%s", m[1], m[2])}, nil
+		}
+	case "in":
+		if len(segments) > 1 {
+			if reProfileIDURL.MatchString(segments[1]) {
+				return &Ref{Kind: RefProfile, URN: "urn:li:fsd_profile:" + segments[1]}, nil
+			}
+			return &Ref{Kind: RefProfile, PublicID: segments[1]}, nil
+		}
+	case "company", "showcase":
+		if len(segments) > 1 {
+			if reNumericID.MatchString(segments[1]) {
+				return &Ref{Kind: RefCompany, URN: "urn:li:fsd_company:" + segments[1]}, nil
+			}
+			return &Ref{Kind: RefCompany, PublicID: segments[1]}, nil
+		}
+	case "school":
+		if len(segments) > 1 {
+			if reNumericID.MatchString(segments[1]) {
+				return &Ref{Kind: RefSchool, URN: "urn:li:fsd_school:" + segments[1]}, nil
+			}
+			return &Ref{Kind: RefSchool, PublicID: segments[1]}, nil
+		}
+	case "jobs":
+		if len(segments) > 2 && segments[1] == "view" {
+			if id := lastNumericPart(segments[2]); id != "" {
+				return &Ref{Kind: RefJob, URN: "urn:li:fsd_jobPosting:" + id}, nil
+			}
+		}
+	case "messaging":
+		if len(segments) > 2 && segments[1] == "thread" {
+			return &Ref{Kind: RefConversation, URN: "urn:li:fs_conversation:" + segments[2]}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unrecognized LinkedIn URL: %s", s)
+}
+
+// ParseURN resolves s to a URN of one of the given kinds. Vanity profile
+// and company URLs are rejected because they need a lookup; use
+// Client.ResolveURN, or Client.ResolveProfileURN and
+// Client.ResolveCompanyURN.
+func ParseURN(s string, kinds ...RefKind) (string, error) {
+	ref, err := ParseRef(s)
+	if err != nil {
+		return "", err
+	}
+
+	if len(kinds) > 0 && !containsKind(kinds, ref.Kind) {
+		return "", fmt.Errorf("expected a %s, got a %s: %s", joinKinds(kinds), ref.Kind, s)
+	}
+	if ref.URN == "" {
+		return "", fmt.Errorf("%s %q needs a lookup to resolve to a URN", ref.Kind, ref.PublicID)
+	}
+	return ref.URN, nil
+}
+
+// ResolveURN is like ParseURN but looks up vanity profile and company URLs
+// through the API instead of rejecting them. Vanity school URLs have no
+// lookup and are rejected: the company lookup would return the URN of the
+// company page behind the school, not the school.
+func (c *Client) ResolveURN(ctx context.Context, s string, kinds ...RefKind) (string, error) {
+	ref, err := ParseRef(s)
+	if err != nil {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+	}
+	if ref.URN != "" {
+		u, err := ParseURN(s, kinds...)
+		if err != nil {
+			return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+		}
+		return u, nil
+	}
+
+	if len(kinds) > 0 && !containsKind(kinds, ref.Kind) {
+		return "", &Error{
+			Code:    ErrCodeInvalidInput,
+			Message: fmt.Sprintf("expected a %s, got a %s: %s", joinKinds(kinds), ref.Kind, s),
+		}
+	}
+	switch ref.Kind {
+	case RefProfile:
+		return c.ResolveProfileURN(ctx, ref.PublicID)
+	case RefCompany:
+		return c.ResolveCompanyURN(ctx, ref.PublicID)
+	}
+	return "", &Error{
+		Code:    ErrCodeInvalidInput,
+		Message: fmt.Sprintf("%s %q cannot be looked up; use its numeric URL or URN", ref.Kind, ref.PublicID),
+	}
+}
+
+// isLinkedInHost reports whether host is linkedin.com or a subdomain.
+func isLinkedInHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "linkedin.com" || strings.HasSuffix(host, ".linkedin.com")
+}
+
+// lastNumericPart returns the trailing number of a slug such as
+// "senior-engineer-3812345678", or "".
+func lastNumericPart(s string) string {
+	parts := strings.Split(s, "-")
+	if last := parts[len(parts)-1]; reNumericID.MatchString(last) {
+		return last
+	}
+	return ""
+}
+
+func containsKind(kinds []RefKind, kind RefKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func joinKinds(kinds []RefKind) string {
+	names := make([]string, len(kinds))
+	for i, k := range kinds {
+		names[i] = string(k)
+	}
+	return strings.Join(names, " or ")
+}
+
+func invalidRef(s string) error {
+	return fmt.Errorf("not a LinkedIn URN or URL: %q", s)
+}
+
+var _ urn.Lookup = (*Client)(nil)
+
+// ResolveActivity returns the activity URN for a share or ugcPost URN. It
+// implements urn.Lookup.
+func (c *Client) ResolveActivity(ctx context.Context, u urn.URN) (urn.URN, error) {
+	post, err := c.GetPost(ctx, u.String())
+	if err != nil {
+		return urn.URN{}, err
+	}
+	activity, err := urn.Parse(post.URN)
+	if err != nil {
+		return urn.URN{}, err
+	}
+	if a, ok := activity.Activity(); ok {
+		return a, nil
+	}
+	return urn.URN{}, &Error{Code: ErrCodeNotFound, Message: "no activity found for " + u.String()}
+}
+
+// ResolveProfile returns the fsd_profile URN for a member URN. It
+// implements urn.Lookup.
+func (c *Client) ResolveProfile(ctx context.Context, u urn.URN) (urn.URN, error) {
+	profile, err := c.GetProfileByURN(ctx, u.String())
+	if err != nil {
+		return urn.URN{}, err
+	}
+	p, err := urn.Parse(profile.URN)
+	if err != nil {
+		return urn.URN{}, err
+	}
+	if fsd, ok := p.Profile(); ok {
+		return fsd, nil
+	}
+	return urn.URN{}, &Error{Code: ErrCodeNotFound, Message: "no profile found for " + u.String()}
+}
+
+// ResolveMember returns the member URN for an fsd_profile URN. It
+// implements urn.Lookup.
+func (c *Client) ResolveMember(ctx context.Context, u urn.URN) (urn.URN, error) {
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/identity/dash/profiles", profileQuery(u), &result); err != nil {
+		return urn.URN{}, err
+	}
+
+	for _, raw := range result.Included {
+		var entity struct {
+			EntityURN string `json:"entityUrn"`
+			ObjectURN string `json:"objectUrn"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil || entity.EntityURN != u.String() {
+			continue
+		}
+		if m, err := urn.Parse(entity.ObjectURN); err == nil && m.Type == urn.TypeMember {
+			return m, nil
+		}
+	}
+	return urn.URN{}, &Error{Code: ErrCodeNotFound, Message: "no member found for " + u.String()}
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantKind     RefKind
+		wantURN      string
+		wantPublicID string
+		wantErr      bool
+	}{
+		{input: "urn:li:activity:7123", wantKind: RefPost, wantURN: "urn:li:activity:7123"},
+		{input: "urn:li:ugcPost:55", wantKind: RefPost, wantURN: "urn:li:ugcPost:55"},
+		{input: "7123", wantKind: RefPost, wantURN: "urn:li:activity:7123"},
+		{
+			input:    "urn:li:fs_updateV2:(urn:li:activity:7123,MAIN_FEED,EMPTY,DEFAULT,false)",
+			wantKind: RefPost,
+			wantURN:  "urn:li:activity:7123",
+		},
+		{
+			input:    "urn:li:fsd_comment:(7124,urn:li:activity:7123)",
+			wantKind: RefComment,
+			wantURN:  "urn:li:fsd_comment:(7124,urn:li:activity:7123)",
+		},
+		{
+			input:    "https://www.linkedin.com/posts/jane_launch-day-activity-7123456789-AbCd/",
+			wantKind: RefPost,
+			wantURN:  "urn:li:activity:7123456789",
+		},
+		{
+			input:    "https://www.linkedin.com/feed/update/urn:li:activity:7123/",
+			wantKind: RefPost,
+			wantURN:  "urn:li:activity:7123",
+		},
+		{
+			input:    "https://www.linkedin.com/feed/update/urn%3Ali%3AugcPost%3A99",
+			wantKind: RefPost,
+			wantURN:  "urn:li:ugcPost:99",
+		},
+		{
+			input:    "https://www.linkedin.com/feed/update/urn:li:activity:1?commentUrn=urn%3Ali%3Acomment%3A%28activity%3A1%2C2%29",
+			wantKind: RefComment,
+			wantURN:  "urn:li:comment:(activity:1,2)",
+		},
+		{input: "https://www.linkedin.com/in/jane/", wantKind: RefProfile, wantPublicID: "jane"},
+		{input: "linkedin.com/in/jane", wantKind: RefProfile, wantPublicID: "jane"},
+		{
+			input:    "https://www.linkedin.com/in/ACoAAB1234xyz",
+			wantKind: RefProfile,
+			wantURN:  "urn:li:fsd_profile:ACoAAB1234xyz",
+		},
+		{input: "urn:li:member:123", wantKind: RefProfile, wantURN: "urn:li:member:123"},
+		{input: "https://www.linkedin.com/company/acme/", wantKind: RefCompany, wantPublicID: "acme"},
+		{input: "https://www.linkedin.com/company/1441/", wantKind: RefCompany, wantURN: "urn:li:fsd_company:1441"},
+		{input: "https://www.linkedin.com/showcase/acme-labs/", wantKind: RefCompany, wantPublicID: "acme-labs"},
+		{input: "https://www.linkedin.com/school/stanford-university/", wantKind: RefSchool, wantPublicID: "stanford-university"},
+		{input: "https://www.linkedin.com/school/1792/", wantKind: RefSchool, wantURN: "urn:li:fsd_school:1792"},
+		{
+			input:    "https://www.linkedin.com/jobs/view/senior-engineer-at-acme-3812345678/",
+			wantKind: RefJob,
+			wantURN:  "urn:li:fsd_jobPosting:3812345678",
+		},
+		{
+			input:    "https://www.linkedin.com/jobs/search/?currentJobId=3812345678",
+			wantKind: RefJob,
+			wantURN:  "urn:li:fsd_jobPosting:3812345678",
+		},
+		{
+			input:    "https://www.linkedin.com/messaging/thread/2-YWJjZA==/",
+			wantKind: RefConversation,
+			wantURN:  "urn:li:fs_conversation:2-YWJjZA==",
+		},
+		{input: "https://example.com/in/jane", wantErr: true},
+		{input: "https://www.linkedin.com/learning/", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ref, err := ParseRef(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRef() error: %v", err)
+			}
+			if ref.Kind != tt.wantKind || ref.URN != tt.wantURN || ref.PublicID != tt.wantPublicID {
+				t.Errorf("ParseRef(%q) = %+v, want kind %q urn %q publicId %q",
+					tt.input, ref, tt.wantKind, tt.wantURN, tt.wantPublicID)
+			}
+		})
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	if _, err := ParseURN("urn:li:member:1", RefPost); err == nil {
+		t.Error("expected kind mismatch error")
+	}
+	if _, err := ParseURN("https://www.linkedin.com/in/jane", RefProfile); err == nil {
+		t.Error("expected lookup error for vanity URL")
+	}
+	got, err := ParseURN("https://www.linkedin.com/feed/update/urn:li:activity:9/", RefPost, RefComment)
+	if err != nil || got != "urn:li:activity:9" {
+		t.Errorf("ParseURN() = %q, %v", got, err)
+	}
+}
+
+func TestResolveURNCompanyVanity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organization/companies" || r.URL.Query().Get("universalName") != "acme" {
+			t.Errorf("request = %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"included": [
+			{"entityUrn": "urn:li:fs_normalized_company:999", "universalName": "other"},
+			{"entityUrn": "urn:li:fs_normalized_company:1234", "universalName": "acme"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	got, err := c.ResolveURN(context.Background(), "https://www.linkedin.com/company/acme/", RefCompany)
+	if err != nil {
+		t.Fatalf("ResolveURN() error: %v", err)
+	}
+	if got != "urn:li:fsd_company:1234" {
+		t.Errorf("ResolveURN() = %q, want urn:li:fsd_company:1234", got)
+	}
+
+	if _, err := c.ResolveURN(context.Background(), "https://www.linkedin.com/company/acme/", RefPost); err == nil {
+		t.Error("expected error for a company URL where a post is expected")
+	}
+}
+
+func TestResolveURNSchoolVanity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	_, err := c.ResolveURN(context.Background(), "https://www.linkedin.com/school/stanford-university/")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != ErrCodeInvalidInput {
+		t.Errorf("ResolveURN(school vanity) error = %v, want an invalid input error", err)
+	}
+	if _, err := c.ResolveURN(context.Background(), "https://www.linkedin.com/school/stanford-university/", RefCompany); err == nil {
+		t.Error("expected error for a school URL where a company is expected")
+	}
+
+	got, err := c.ResolveURN(context.Background(), "https://www.linkedin.com/school/1792/", RefSchool)
+	if err != nil || got != "urn:li:fsd_school:1792" {
+		t.Errorf("ResolveURN(numeric school) = %q, %v", got, err)
+	}
+}
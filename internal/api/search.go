@@ -0,0 +1,690 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pp/lnk/internal/urn"
+)
+
+// SearchFilter is a search query parameter such as
+// (key:network,value:List(F,S)).
+type SearchFilter struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// encodeSearchFilters encodes filters as a Rest.li list of query
+// parameters. Values are escaped so that spaces and Rest.li delimiters in
+// free text cannot break the variables.
+func encodeSearchFilters(filters []SearchFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		values := make([]string, len(f.Values))
+		for i, v := range f.Values {
+			values[i] = url.QueryEscape(v)
+		}
+		parts = append(parts, fmt.Sprintf("(key:%s,value:List(%s))", f.Key, strings.Join(values, ",")))
+	}
+	return "List(" + strings.Join(parts, ",") + ")"
+}
+
+// searchOrigin returns the search origin LinkedIn's web client sends: a
+// faceted search once filters are applied.
+func searchOrigin(filters []SearchFilter) string {
+	if len(filters) > 0 {
+		return "FACETED_SEARCH"
+	}
+	return "GLOBAL_SEARCH_HEADER"
+}
+
+// Network degree filter values.
+const (
+	NetworkFirst  = "F"
+	NetworkSecond = "S"
+	NetworkThird  = "O"
+)
+
+// ParseNetwork converts a connection degree such as "1st", "2nd", "3rd+"
+// or "2" into a network filter value.
+func ParseNetwork(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "1st", "first", "f":
+		return NetworkFirst, nil
+	case "2", "2nd", "second", "s":
+		return NetworkSecond, nil
+	case "3", "3rd", "3rd+", "third", "o":
+		return NetworkThird, nil
+	default:
+		return "", fmt.Errorf("invalid network degree %q: use 1st, 2nd or 3rd", s)
+	}
+}
+
+// PeopleFilters narrows a people search. Locations, companies, industries
+// and schools may be names, which ResolvePeopleFilters looks up, or URNs
+// and numeric IDs, which are used as is.
+type PeopleFilters struct {
+	Network          []string `json:"network,omitempty"`
+	Locations        []string `json:"locations,omitempty"`
+	CurrentCompanies []string `json:"currentCompanies,omitempty"`
+	PastCompanies    []string `json:"pastCompanies,omitempty"`
+	Industries       []string `json:"industries,omitempty"`
+	Schools          []string `json:"schools,omitempty"`
+	Title            string   `json:"title,omitempty"`
+}
+
+// ResolvePeopleFilters turns people filters into search query parameters,
+// resolving names to geo, company, industry and school IDs through
+// typeahead lookups.
+func (c *Client) ResolvePeopleFilters(ctx context.Context, f *PeopleFilters) ([]SearchFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	var filters []SearchFilter
+	if len(f.Network) > 0 {
+		values := make([]string, 0, len(f.Network))
+		for _, n := range f.Network {
+			v, err := ParseNetwork(n)
+			if err != nil {
+				return nil, &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+			}
+			values = append(values, v)
+		}
+		filters = append(filters, SearchFilter{Key: "network", Values: values})
+	}
+
+	lookups := []struct {
+		key   string
+		kind  string
+		names []string
+	}{
+		{"geoUrn", TypeaheadGeo, f.Locations},
+		{"currentCompany", TypeaheadCompany, f.CurrentCompanies},
+		{"pastCompany", TypeaheadCompany, f.PastCompanies},
+		{"industry", TypeaheadIndustry, f.Industries},
+		{"schoolFilter", TypeaheadSchool, f.Schools},
+	}
+	for _, l := range lookups {
+		if len(l.names) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(l.names))
+		for _, name := range l.names {
+			id, err := c.resolveFilterID(ctx, l.kind, name)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, id)
+		}
+		filters = append(filters, SearchFilter{Key: l.key, Values: values})
+	}
+
+	if title := strings.TrimSpace(f.Title); title != "" {
+		filters = append(filters, SearchFilter{Key: "title", Values: []string{title}})
+	}
+
+	return filters, nil
+}
+
+// resolveFilterID returns the numeric ID search filters use for name: the
+// ID itself, the ID of a URN or company URL, or the top typeahead hit.
+func (c *Client) resolveFilterID(ctx context.Context, kind, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: "empty filter value"}
+	}
+	if reNumericID.MatchString(name) {
+		return name, nil
+	}
+	if strings.HasPrefix(name, "urn:") {
+		u, err := urn.Parse(name)
+		if err != nil {
+			return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+		}
+		return u.ID, nil
+	}
+	if kind == TypeaheadCompany && strings.Contains(name, "linkedin.com/") {
+		companyURN, err := c.ResolveCompanyURN(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		u, err := urn.Parse(companyURN)
+		if err != nil {
+			return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+		}
+		return u.ID, nil
+	}
+
+	hits, err := c.Typeahead(ctx, kind, name)
+	if err != nil {
+		return "", err
+	}
+	for _, h := range hits {
+		if reNumericID.MatchString(h.ID) {
+			return h.ID, nil
+		}
+	}
+	return "", &Error{
+		Code:    ErrCodeNotFound,
+		Message: fmt.Sprintf("no %s matches %q", strings.ToLower(kind), name),
+	}
+}
+
+// searchPageSize is the number of results LinkedIn returns per search page.
+const searchPageSize = 10
+
+// Post search sort orders.
+const (
+	SortRelevance = "relevance"
+	SortRecent    = "recent"
+)
+
+// Post search date ranges.
+const (
+	DatePostedPast24h   = "past-24h"
+	DatePostedPastWeek  = "past-week"
+	DatePostedPastMonth = "past-month"
+)
+
+// PostSearchOptions configures a post (content) search.
+type PostSearchOptions struct {
+	Limit int `json:"limit,omitempty"`
+	Start int `json:"start,omitempty"`
+	// Sort is SortRelevance (default) or SortRecent.
+	Sort string `json:"sort,omitempty"`
+	// DatePosted is one of the DatePosted constants, or "" for any time.
+	DatePosted string `json:"datePosted,omitempty"`
+	// FromMembers limits results to posts by these members, given as
+	// usernames, profile URLs or URNs.
+	FromMembers []string `json:"fromMembers,omitempty"`
+}
+
+// postSearchFilters converts post search options into query parameters,
+// resolving member references to profile IDs.
+func (c *Client) postSearchFilters(ctx context.Context, opts *PostSearchOptions) ([]SearchFilter, error) {
+	var filters []SearchFilter
+
+	switch opts.Sort {
+	case "", SortRelevance:
+	case SortRecent:
+		filters = append(filters, SearchFilter{Key: "sortBy", Values: []string{"date_posted"}})
+	default:
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("invalid sort %q: use %s or %s", opts.Sort, SortRecent, SortRelevance)}
+	}
+
+	switch opts.DatePosted {
+	case "":
+	case DatePostedPast24h, DatePostedPastWeek, DatePostedPastMonth:
+		filters = append(filters, SearchFilter{Key: "datePosted", Values: []string{opts.DatePosted}})
+	default:
+		return nil, &Error{
+			Code:    ErrCodeInvalidInput,
+			Message: fmt.Sprintf("invalid date posted %q: use %s, %s or %s", opts.DatePosted, DatePostedPast24h, DatePostedPastWeek, DatePostedPastMonth),
+		}
+	}
+
+	if len(opts.FromMembers) > 0 {
+		values := make([]string, 0, len(opts.FromMembers))
+		for _, m := range opts.FromMembers {
+			profileURN, err := c.ResolveProfileURN(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			u, err := urn.Parse(profileURN)
+			if err != nil {
+				return nil, &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+			}
+			values = append(values, u.ID)
+		}
+		filters = append(filters, SearchFilter{Key: "fromMember", Values: values})
+	}
+
+	return filters, nil
+}
+
+// SearchPosts searches posts using the CONTENT result type, paging until
+// opts.Limit posts are collected or there are no more.
+func (c *Client) SearchPosts(ctx context.Context, query string, opts *PostSearchOptions) ([]Post, error) {
+	if opts == nil {
+		opts = &PostSearchOptions{Limit: 10}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+
+	filters, err := c.postSearchFilters(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := []Post{}
+	seen := make(map[string]bool)
+	for start := opts.Start; len(posts) < opts.Limit; start += searchPageSize {
+		var result VoyagerResponse
+		if err := c.Get(ctx, buildSearchPath(query, "CONTENT", start, filters), nil, &result); err != nil {
+			return nil, err
+		}
+
+		items, err := parseFeedFromResponse(&result)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, item := range items {
+			if item.Post == nil || seen[item.Post.URN] {
+				continue
+			}
+			seen[item.Post.URN] = true
+			posts = append(posts, *item.Post)
+			added++
+			if len(posts) == opts.Limit {
+				break
+			}
+		}
+		if added == 0 {
+			break
+		}
+	}
+
+	if opts.Sort == SortRecent {
+		sort.SliceStable(posts, func(i, j int) bool {
+			return posts[i].CreatedAt.After(posts[j].CreatedAt)
+		})
+	}
+	return posts, nil
+}
+
+// searchEntity is an EntityResultViewModel, the entry a search cluster
+// holds for each person, company, school, group or event result.
+type searchEntity struct {
+	Type              string    `json:"$type"`
+	Title             *textView `json:"title"`
+	PrimarySubtitle   *textView `json:"primarySubtitle"`
+	SecondarySubtitle *textView `json:"secondarySubtitle"`
+	Summary           *textView `json:"summary"`
+	BadgeText         *textView `json:"badgeText"`
+	BadgeIcon         *struct {
+		Attributes []struct {
+			DetailData struct {
+				Icon string `json:"icon"`
+			} `json:"detailData"`
+		} `json:"attributes"`
+	} `json:"badgeIcon"`
+	Image                    *imageView `json:"image"`
+	NavigationURL            string     `json:"navigationUrl"`
+	EntityURN                string     `json:"entityUrn"`
+	TrackingURN              string     `json:"trackingUrn"`
+	EntityCustomTrackingInfo *struct {
+		MemberDistance string `json:"memberDistance"`
+	} `json:"entityCustomTrackingInfo"`
+	InsightsResolutionResults []struct {
+		SimpleInsight *struct {
+			Title textView `json:"title"`
+		} `json:"simpleInsight"`
+	} `json:"insightsResolutionResults"`
+}
+
+// searchEntityType is the $type of search cluster results.
+const searchEntityType = "com.linkedin.voyager.dash.search.EntityResultViewModel"
+
+// text returns the text of v, or "" if v is nil.
+func (v *textView) text() string {
+	if v == nil {
+		return ""
+	}
+	return v.Text
+}
+
+// target returns the URN of the entity the result is about: the first
+// recognizable URN nested in the result's entity URN, else its tracking URN.
+// Profiles are returned in fsd_profile form so results can be passed
+// straight to profile and messaging calls.
+func (e *searchEntity) target() urn.URN {
+	if u, err := urn.Parse(e.EntityURN); err == nil {
+		for _, n := range u.Nested() {
+			if p, ok := n.Profile(); ok {
+				return p
+			}
+			if n.Kind() != urn.KindUnknown {
+				return n
+			}
+		}
+	}
+	u, _ := urn.Parse(e.TrackingURN)
+	return u
+}
+
+// insight returns the first insight line, such as "1,234 attendees".
+func (e *searchEntity) insight() string {
+	for _, r := range e.InsightsResolutionResults {
+		if r.SimpleInsight != nil && r.SimpleInsight.Title.Text != "" {
+			return r.SimpleInsight.Title.Text
+		}
+	}
+	return ""
+}
+
+// searchKinds lists the URN kinds a result type accepts. Schools are backed
+// by company pages, so their results may carry company URNs; see
+// acceptsResult.
+var searchKinds = map[string][]urn.Kind{
+	SearchPeople:    {urn.KindProfile},
+	SearchCompanies: {urn.KindCompany},
+	SearchSchools:   {urn.KindSchool, urn.KindCompany},
+	SearchGroups:    {urn.KindGroup},
+	SearchEvents:    {urn.KindEvent},
+}
+
+// acceptsResult tells company pages and the company pages backing schools
+// apart by their URL, since both carry company URNs.
+func acceptsResult(resultType string, target urn.URN, navigationURL string) bool {
+	if target.Kind() != urn.KindCompany {
+		return true
+	}
+	isSchool := strings.Contains(navigationURL, "linkedin.com/school/")
+	switch resultType {
+	case SearchSchools:
+		return isSchool
+	case SearchCompanies:
+		return !isSchool
+	}
+	return true
+}
+
+// parseSearchResults extracts results of the given type from the entities
+// of a search clusters response. Results about other kinds of entities,
+// such as people suggested alongside companies, are skipped.
+func parseSearchResults(included []json.RawMessage, resultType string) []SearchResult {
+	kinds := searchKinds[resultType]
+	index := newEntityIndex(included)
+
+	var results []SearchResult
+	seen := make(map[string]bool)
+	for _, raw := range included {
+		var e searchEntity
+		if err := json.Unmarshal(raw, &e); err != nil || e.Type != searchEntityType {
+			continue
+		}
+		target := e.target()
+		if !slices.Contains(kinds, target.Kind()) || !acceptsResult(resultType, target, e.NavigationURL) {
+			continue
+		}
+		if seen[target.String()] {
+			continue
+		}
+		seen[target.String()] = true
+
+		r := SearchResult{URN: target.String(), Type: resultType}
+		name := e.Title.text()
+		url := stripQuery(e.NavigationURL)
+		switch resultType {
+		case SearchPeople:
+			r.Profile = parsePersonResult(&e, r.URN, index)
+		case SearchCompanies:
+			r.Company = &Company{
+				URN:           e.TrackingURN,
+				Name:          name,
+				FollowerCount: e.SecondarySubtitle.text(),
+				Description:   e.Summary.text(),
+				CompanyURL:    e.NavigationURL,
+			}
+			if r.Company.URN == "" {
+				r.Company.URN = r.URN
+			}
+			// The primary subtitle is "Industry • Location".
+			industry, location, _ := strings.Cut(e.PrimarySubtitle.text(), " • ")
+			r.Company.Industry, r.Company.Location = industry, location
+		case SearchSchools:
+			r.School = &School{
+				URN:           r.URN,
+				Name:          name,
+				Location:      e.PrimarySubtitle.text(),
+				FollowerCount: e.SecondarySubtitle.text(),
+				Description:   e.Summary.text(),
+				URL:           url,
+			}
+		case SearchGroups:
+			r.Group = &Group{
+				URN:         r.URN,
+				Name:        name,
+				MemberCount: e.PrimarySubtitle.text(),
+				Description: e.Summary.text(),
+				URL:         url,
+			}
+		case SearchEvents:
+			r.Event = &Event{
+				URN:         r.URN,
+				Name:        name,
+				Time:        e.PrimarySubtitle.text(),
+				Location:    e.SecondarySubtitle.text(),
+				Attendees:   e.insight(),
+				Description: e.Summary.text(),
+				URL:         url,
+			}
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// hiddenMemberName is the title LinkedIn shows for people outside your
+// network.
+const hiddenMemberName = "LinkedIn Member"
+
+// searchProfileEntity is the profile a people search result refers to.
+type searchProfileEntity struct {
+	FirstName        string `json:"firstName"`
+	LastName         string `json:"lastName"`
+	PublicIdentifier string `json:"publicIdentifier"`
+	ProfilePicture   *struct {
+		DisplayImageReference *struct {
+			VectorImage *vectorImage `json:"vectorImage"`
+		} `json:"displayImageReference"`
+	} `json:"profilePicture"`
+}
+
+// parsePersonResult builds a profile from a people search result. Names and
+// the picture come from the referenced profile entity when the response
+// includes it, since only it knows where the first name ends.
+func parsePersonResult(e *searchEntity, profileURN string, index entityIndex) *Profile {
+	p := &Profile{
+		URN:               profileURN,
+		Name:              strings.TrimSpace(e.Title.text()),
+		Headline:          e.PrimarySubtitle.text(),
+		Location:          e.SecondarySubtitle.text(),
+		Degree:            e.degree(),
+		Premium:           e.premium(),
+		MutualConnections: mutualConnections(e.insight()),
+	}
+	if pic, _, _ := e.Image.vector().largest(); pic != "" {
+		p.ProfilePicURL = pic
+	}
+
+	if p.Name == hiddenMemberName || strings.Contains(e.NavigationURL, "/search/results/people/headless") {
+		p.Hidden = true
+		p.Name = hiddenMemberName
+		return p
+	}
+
+	p.ProfileURL = e.NavigationURL
+	if ref, err := ParseRef(stripQuery(e.NavigationURL)); err == nil && ref.Kind == RefProfile {
+		p.PublicID = ref.PublicID
+	}
+
+	// Names are only split when the profile entity says where; display
+	// names such as "Mary Ann O'Neil" cannot be split reliably.
+	var entity searchProfileEntity
+	if index.decode(profileURN, &entity) && entity.FirstName != "" {
+		p.FirstName, p.LastName = entity.FirstName, entity.LastName
+		if entity.PublicIdentifier != "" {
+			p.PublicID = entity.PublicIdentifier
+		}
+		if pic := entity.ProfilePicture; p.ProfilePicURL == "" && pic != nil && pic.DisplayImageReference != nil {
+			p.ProfilePicURL, _, _ = pic.DisplayImageReference.VectorImage.largest()
+		}
+	}
+	return p
+}
+
+// memberDistances maps tracking member distances to network degrees.
+var memberDistances = map[string]string{
+	"DISTANCE_1": "1st",
+	"DISTANCE_2": "2nd",
+	"DISTANCE_3": "3rd+",
+}
+
+// degree returns the network degree shown on the result, such as "2nd" or
+// "3rd+", or "".
+func (e *searchEntity) degree() string {
+	// The badge reads "• 2nd".
+	if d := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(e.BadgeText.text()), "•")); d != "" {
+		if d == "3rd" {
+			return "3rd+"
+		}
+		return d
+	}
+	if e.EntityCustomTrackingInfo != nil {
+		return memberDistances[e.EntityCustomTrackingInfo.MemberDistance]
+	}
+	return ""
+}
+
+// premium reports whether the result carries the Premium badge.
+func (e *searchEntity) premium() bool {
+	if e.BadgeIcon == nil {
+		return false
+	}
+	for _, a := range e.BadgeIcon.Attributes {
+		if strings.Contains(a.DetailData.Icon, "PREMIUM") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	reOtherMutual = regexp.MustCompile(`and ([\d,]+) other mutual connections?$`)
+	reMutualCount = regexp.MustCompile(`^([\d,]+) mutual connections?$`)
+)
+
+// mutualConnections reads the number of shared connections from an insight
+// such as "Jane Roe and 12 other mutual connections", "Jane Roe and John
+// Doe are mutual connections" or "Jane Roe is a mutual connection". It
+// returns 0 for other insights.
+func mutualConnections(insight string) int {
+	if !strings.Contains(insight, "mutual connection") {
+		return 0
+	}
+	if m := reMutualCount.FindStringSubmatch(insight); m != nil {
+		n, _ := strconv.Atoi(strings.ReplaceAll(m[1], ",", ""))
+		return n
+	}
+
+	// Count the people named before the count or verb.
+	names := insight
+	others := 0
+	if m := reOtherMutual.FindStringSubmatchIndex(insight); m != nil {
+		others, _ = strconv.Atoi(strings.ReplaceAll(insight[m[2]:m[3]], ",", ""))
+		names = insight[:m[0]]
+	} else if i := strings.LastIndex(insight, " are mutual"); i >= 0 {
+		names = insight[:i]
+	} else if i := strings.LastIndex(insight, " is a mutual"); i >= 0 {
+		names = insight[:i]
+	}
+	named := 0
+	for _, part := range strings.Split(strings.ReplaceAll(names, " and ", ", "), ",") {
+		if strings.TrimSpace(part) != "" {
+			named++
+		}
+	}
+	return named + others
+}
+
+// Search runs a search of the given result type (one of SearchPeople,
+// SearchCompanies, SearchSchools, SearchGroups or SearchEvents) and returns
+// at most opts.Limit results from the page at opts.Start.
+func (c *Client) Search(ctx context.Context, resultType, query string, opts *SearchOptions) ([]SearchResult, error) {
+	if _, ok := searchKinds[resultType]; !ok {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("unsupported search type %q", resultType)}
+	}
+	if opts == nil {
+		opts = &SearchOptions{Limit: 10}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+
+	results := []SearchResult{}
+	seen := make(map[string]bool)
+	for start := opts.Start; len(results) < opts.Limit; start += searchPageSize {
+		var result searchResult
+		if err := c.Get(ctx, buildSearchPath(query, resultType, start, opts.Filters), nil, &result); err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, r := range parseSearchResults(result.Included, resultType) {
+			if seen[r.URN] {
+				continue
+			}
+			seen[r.URN] = true
+			results = append(results, r)
+			added++
+			if len(results) == opts.Limit {
+				break
+			}
+		}
+		if added == 0 {
+			break
+		}
+	}
+	return results, nil
+}
+
+// SearchSchools searches for schools on LinkedIn.
+func (c *Client) SearchSchools(ctx context.Context, query string, opts *SearchOptions) ([]School, error) {
+	results, err := c.Search(ctx, SearchSchools, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	schools := make([]School, 0, len(results))
+	for _, r := range results {
+		schools = append(schools, *r.School)
+	}
+	return schools, nil
+}
+
+// SearchGroups searches for groups on LinkedIn.
+func (c *Client) SearchGroups(ctx context.Context, query string, opts *SearchOptions) ([]Group, error) {
+	results, err := c.Search(ctx, SearchGroups, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]Group, 0, len(results))
+	for _, r := range results {
+		groups = append(groups, *r.Group)
+	}
+	return groups, nil
+}
+
+// SearchEvents searches for events on LinkedIn.
+func (c *Client) SearchEvents(ctx context.Context, query string, opts *SearchOptions) ([]Event, error) {
+	results, err := c.Search(ctx, SearchEvents, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, 0, len(results))
+	for _, r := range results {
+		events = append(events, *r.Event)
+	}
+	return events, nil
+}
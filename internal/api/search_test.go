@@ -0,0 +1,367 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBuildSearchPath(t *testing.T) {
+	path := buildSearchPath("go developer", "PEOPLE", 10, []SearchFilter{
+		{Key: "network", Values: []string{"F", "S"}},
+		{Key: "title", Values: []string{"Staff (Platform), Eng"}},
+	})
+
+	for _, want := range []string{
+		"start:10",
+		"origin:FACETED_SEARCH",
+		"keywords:go+developer",
+		"queryParameters:List((key:resultType,value:List(PEOPLE)),(key:network,value:List(F,S)),(key:title,value:List(Staff+%28Platform%29%2C+Eng)))",
+	} {
+		if !strings.Contains(path, want) {
+			t.Errorf("path missing %q:\n%s", want, path)
+		}
+	}
+
+	if path := buildSearchPath("acme", "COMPANIES", 0, nil); !strings.Contains(path, "origin:GLOBAL_SEARCH_HEADER") ||
+		!strings.Contains(path, "queryParameters:List((key:resultType,value:List(COMPANIES)))") {
+		t.Errorf("unfiltered path = %s", path)
+	}
+}
+
+func TestParseNetwork(t *testing.T) {
+	tests := map[string]string{"1st": "F", "2": "S", "3rd+": "O", " 2nd ": "S"}
+	for in, want := range tests {
+		if got, err := ParseNetwork(in); err != nil || got != want {
+			t.Errorf("ParseNetwork(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseNetwork("4th"); err == nil {
+		t.Error("ParseNetwork(4th) should fail")
+	}
+}
+
+func TestResolvePeopleFilters(t *testing.T) {
+	var lookups []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/typeahead/hitsV2" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		lookups = append(lookups, q.Get("type")+":"+q.Get("keywords"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("type") {
+		case TypeaheadGeo:
+			_, _ = w.Write([]byte(`{"data": {"elements": [{"text": {"text": "Berlin, Germany"}, "targetUrn": "urn:li:fs_geo:103035651"}]}}`))
+		case TypeaheadCompany:
+			_, _ = w.Write([]byte(`{"data": {}, "included": [{"$type": "com.linkedin.voyager.typeahead.TypeaheadHitV2", "text": {"text": "Acme"}, "objectUrn": "urn:li:company:1234"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": {"elements": []}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	filters, err := c.ResolvePeopleFilters(context.Background(), &PeopleFilters{
+		Network:          []string{"1st", "2nd"},
+		Locations:        []string{"Berlin"},
+		CurrentCompanies: []string{"Acme"},
+		PastCompanies:    []string{"urn:li:company:99", "https://www.linkedin.com/company/42/"},
+		Title:            "engineer",
+	})
+	if err != nil {
+		t.Fatalf("ResolvePeopleFilters() error: %v", err)
+	}
+
+	got := encodeSearchFilters(filters)
+	want := "List((key:network,value:List(F,S)),(key:geoUrn,value:List(103035651)),(key:currentCompany,value:List(1234)),(key:pastCompany,value:List(99,42)),(key:title,value:List(engineer)))"
+	if got != want {
+		t.Errorf("filters = %s\nwant      %s", got, want)
+	}
+	if strings.Join(lookups, ",") != "GEO:Berlin,COMPANY:Acme" {
+		t.Errorf("lookups = %v", lookups)
+	}
+
+	_, err = c.ResolvePeopleFilters(context.Background(), &PeopleFilters{Schools: []string{"Nowhere U"}})
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != ErrCodeNotFound {
+		t.Errorf("unknown school error = %v, want NOT_FOUND", err)
+	}
+}
+
+func TestSearchPeopleSendsFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars, _ := url.QueryUnescape(r.URL.RawQuery)
+		if !strings.Contains(vars, "(key:geoUrn,value:List(103035651))") {
+			t.Errorf("query = %s", vars)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"included": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	_, err := c.SearchPeople(context.Background(), "engineer", &SearchOptions{
+		Filters: []SearchFilter{{Key: "geoUrn", Values: []string{"103035651"}}},
+	})
+	if err != nil {
+		t.Fatalf("SearchPeople() error: %v", err)
+	}
+}
+
+func TestSearchPosts(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars, _ := url.QueryUnescape(r.URL.RawQuery)
+		for _, want := range []string{
+			"(key:resultType,value:List(CONTENT))",
+			"(key:sortBy,value:List(date_posted))",
+			"(key:datePosted,value:List(past-week))",
+			"(key:fromMember,value:List(ACoAAA))",
+		} {
+			if !strings.Contains(vars, want) {
+				t.Errorf("query missing %s: %s", want, vars)
+			}
+		}
+		start := vars[strings.Index(vars, "start:")+6:]
+		start = start[:strings.Index(start, ",")]
+		starts = append(starts, start)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch start {
+		case "0":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7100000000000000000,SEARCH)", "commentary": {"text": {"text": "older lnk post"}}},
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,SEARCH)", "commentary": {"text": {"text": "newer lnk post"}}}
+			]}`))
+		case "10":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,SEARCH)", "commentary": {"text": {"text": "newer lnk post"}}}
+			]}`))
+		default:
+			t.Errorf("unexpected page %s", start)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	posts, err := c.SearchPosts(context.Background(), "lnk", &PostSearchOptions{
+		Limit:       5,
+		Sort:        SortRecent,
+		DatePosted:  DatePostedPastWeek,
+		FromMembers: []string{"urn:li:fsd_profile:ACoAAA"},
+	})
+	if err != nil {
+		t.Fatalf("SearchPosts() error: %v", err)
+	}
+	if len(posts) != 2 || posts[0].Text != "newer lnk post" {
+		t.Errorf("posts = %+v", posts)
+	}
+	if strings.Join(starts, ",") != "0,10" {
+		t.Errorf("starts = %v, want 0,10", starts)
+	}
+
+	if _, err := c.SearchPosts(context.Background(), "lnk", &PostSearchOptions{DatePosted: "yesterday"}); err == nil {
+		t.Error("expected error for invalid date posted")
+	}
+}
+
+func TestSearchPagesUntilLimit(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars, _ := url.QueryUnescape(r.URL.RawQuery)
+		start := vars[strings.Index(vars, "start:")+6:]
+		start = start[:strings.Index(start, ",")]
+		starts = append(starts, start)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch start {
+		case "0":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel", "entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_company:1,SEARCH_SRP,DEFAULT)", "title": {"text": "One"}},
+				{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel", "entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_company:2,SEARCH_SRP,DEFAULT)", "title": {"text": "Two"}}
+			]}`))
+		case "10":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel", "entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_company:2,SEARCH_SRP,DEFAULT)", "title": {"text": "Two"}},
+				{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel", "entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_company:3,SEARCH_SRP,DEFAULT)", "title": {"text": "Three"}}
+			]}`))
+		case "20":
+			_, _ = w.Write([]byte(`{"included": []}`))
+		default:
+			t.Errorf("unexpected page %s", start)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	results, err := c.Search(context.Background(), SearchCompanies, "acme", &SearchOptions{Limit: 20})
+	if err != nil {
+		t.Fatalf("Search() error: %v", err)
+	}
+	if len(results) != 3 || results[2].Company.Name != "Three" {
+		t.Errorf("results = %+v", results)
+	}
+	if strings.Join(starts, ",") != "0,10,20" {
+		t.Errorf("starts = %v, want 0,10,20", starts)
+	}
+}
+
+func TestParseSearchResults(t *testing.T) {
+	included := []json.RawMessage{
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_profile:ACoAAA,SEARCH_SRP,DEFAULT)",
+			"trackingUrn": "urn:li:member:123",
+			"title": {"text": "Jane van Doe"}, "primarySubtitle": {"text": "Engineer"},
+			"navigationUrl": "https://www.linkedin.com/in/janedoe?miniProfileUrn=x"}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:company:1035,SEARCH_SRP,DEFAULT)",
+			"trackingUrn": "urn:li:company:1035",
+			"title": {"text": "Microsoft"}, "primarySubtitle": {"text": "Software Development • Redmond, WA"},
+			"secondarySubtitle": {"text": "24M followers"}}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:company:2517,SEARCH_SRP,DEFAULT)",
+			"title": {"text": "MIT"}, "primarySubtitle": {"text": "Cambridge, MA"},
+			"navigationUrl": "https://www.linkedin.com/school/mit/"}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_school:1792,SEARCH_SRP,DEFAULT)",
+			"title": {"text": "Stanford University"}, "primarySubtitle": {"text": "Stanford, CA"},
+			"navigationUrl": "https://www.linkedin.com/school/stanford-university/?trk=x"}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:group:4096,SEARCH_SRP,DEFAULT)",
+			"title": {"text": "Gophers"}, "primarySubtitle": {"text": "12K members"}}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_event:7200,SEARCH_SRP,DEFAULT)",
+			"title": {"text": "GopherCon"}, "primarySubtitle": {"text": "Thu, Oct 23, 2026"},
+			"secondarySubtitle": {"text": "Online"},
+			"insightsResolutionResults": [{"simpleInsight": {"title": {"text": "1,234 attendees"}}}]}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_event:7200,SEARCH_SRP,DEFAULT)",
+			"title": {"text": "GopherCon"}}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.SearchClusterViewModel"}`),
+	}
+
+	people := parseSearchResults(included, SearchPeople)
+	if len(people) != 1 {
+		t.Fatalf("people = %+v", people)
+	}
+	if p := people[0].Profile; p.URN != "urn:li:fsd_profile:ACoAAA" || p.Name != "Jane van Doe" || p.FirstName != "" || p.LastName != "" || p.PublicID != "janedoe" {
+		t.Errorf("profile = %+v", p)
+	}
+
+	companies := parseSearchResults(included, SearchCompanies)
+	if len(companies) != 1 {
+		t.Fatalf("companies = %+v", companies)
+	}
+	if c := companies[0].Company; c.Name != "Microsoft" || c.Industry != "Software Development" || c.Location != "Redmond, WA" || c.FollowerCount != "24M followers" {
+		t.Errorf("company = %+v", c)
+	}
+
+	schools := parseSearchResults(included, SearchSchools)
+	if len(schools) != 2 || schools[0].School.Name != "MIT" || schools[1].School.Name != "Stanford University" {
+		t.Fatalf("schools = %+v, want the company-backed school and the school, not other companies", schools)
+	}
+	if s := schools[1].School; s.URN != "urn:li:fsd_school:1792" || s.Location != "Stanford, CA" || s.URL != "https://www.linkedin.com/school/stanford-university/" {
+		t.Errorf("school = %+v", s)
+	}
+
+	groups := parseSearchResults(included, SearchGroups)
+	if len(groups) != 1 || groups[0].Group.Name != "Gophers" || groups[0].Group.MemberCount != "12K members" {
+		t.Errorf("groups = %+v", groups)
+	}
+
+	events := parseSearchResults(included, SearchEvents)
+	if len(events) != 1 {
+		t.Fatalf("events = %+v", events)
+	}
+	if e := events[0].Event; e.URN != "urn:li:fsd_event:7200" || e.Time != "Thu, Oct 23, 2026" || e.Location != "Online" || e.Attendees != "1,234 attendees" {
+		t.Errorf("event = %+v", e)
+	}
+}
+
+func TestParseSearchPeopleResults(t *testing.T) {
+	included := []json.RawMessage{
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_profile:ACoMARY,SEARCH_SRP,DEFAULT)",
+			"trackingUrn": "urn:li:member:1",
+			"title": {"text": "Mary Ann O'Neil"},
+			"badgeText": {"text": "• 2nd"},
+			"badgeIcon": {"attributes": [{"detailData": {"icon": "IC_LINKEDIN_PREMIUM_GOLD_ICON_INBUG"}}]},
+			"image": {"attributes": [{"detailData": {"nonEntityProfilePicture": {"vectorImage": {"rootUrl": "https://media.licdn.com/",
+				"artifacts": [{"width": 100, "fileIdentifyingUrlPathSegment": "100.jpg"}, {"width": 400, "fileIdentifyingUrlPathSegment": "400.jpg"}]}}}}]},
+			"insightsResolutionResults": [{"simpleInsight": {"title": {"text": "Jane Roe and 12 other mutual connections"}}}],
+			"navigationUrl": "https://www.linkedin.com/in/maryann?miniProfileUrn=x"}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.identity.profile.Profile",
+			"entityUrn": "urn:li:fsd_profile:ACoMARY", "firstName": "Mary Ann", "lastName": "O'Neil", "publicIdentifier": "mary-ann-oneil"}`),
+		json.RawMessage(`{"$type": "com.linkedin.voyager.dash.search.EntityResultViewModel",
+			"entityUrn": "urn:li:fsd_entityResultViewModel:(urn:li:fsd_profile:ACoHIDDEN,SEARCH_SRP,DEFAULT)",
+			"trackingUrn": "urn:li:member:2",
+			"title": {"text": "LinkedIn Member"}, "primarySubtitle": {"text": "Engineer"},
+			"entityCustomTrackingInfo": {"memberDistance": "DISTANCE_3"},
+			"navigationUrl": "https://www.linkedin.com/search/results/people/headless?origin=SEARCH"}`),
+	}
+
+	results := parseSearchResults(included, SearchPeople)
+	if len(results) != 2 {
+		t.Fatalf("results = %+v", results)
+	}
+
+	mary := results[0].Profile
+	want := Profile{
+		URN:               "urn:li:fsd_profile:ACoMARY",
+		FirstName:         "Mary Ann",
+		LastName:          "O'Neil",
+		ProfileURL:        "https://www.linkedin.com/in/maryann?miniProfileUrn=x",
+		ProfilePicURL:     "https://media.licdn.com/400.jpg",
+		PublicID:          "mary-ann-oneil",
+		Name:              "Mary Ann O'Neil",
+		Degree:            "2nd",
+		Premium:           true,
+		MutualConnections: 13,
+	}
+	if *mary != want {
+		t.Errorf("profile = %+v\nwant      %+v", *mary, want)
+	}
+
+	hidden := results[1].Profile
+	if !hidden.Hidden || hidden.Name != "LinkedIn Member" || hidden.FirstName != "" || hidden.ProfileURL != "" || hidden.Degree != "3rd+" || hidden.Headline != "Engineer" {
+		t.Errorf("hidden profile = %+v", hidden)
+	}
+}
+
+func TestMutualConnections(t *testing.T) {
+	tests := map[string]int{
+		"Jane Roe is a mutual connection":                       1,
+		"Jane Roe and John Doe are mutual connections":          2,
+		"Jane Roe, John Doe and 1,204 other mutual connections": 1206,
+		"Jane Roe and 1 other mutual connection":                2,
+		"12 mutual connections":                                 12,
+		"Followed by 3 people you know":                         0,
+		"":                                                      0,
+	}
+	for insight, want := range tests {
+		if got := mutualConnections(insight); got != want {
+			t.Errorf("mutualConnections(%q) = %d, want %d", insight, got, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// socialActivityCounts is LinkedIn's engagement summary for a post or
+// comment.
+type socialActivityCounts struct {
+	NumLikes           int `json:"numLikes"`
+	NumComments        int `json:"numComments"`
+	NumShares          int `json:"numShares"`
+	ReactionTypeCounts []struct {
+		ReactionType string `json:"reactionType"`
+		Count        int    `json:"count"`
+	} `json:"reactionTypeCounts"`
+}
+
+// likes returns the total number of reactions. LinkedIn reports all
+// reaction types under numLikes, but older responses only fill the
+// per-type counts.
+func (c socialActivityCounts) likes() int {
+	if c.NumLikes > 0 {
+		return c.NumLikes
+	}
+	total := 0
+	for _, rc := range c.ReactionTypeCounts {
+		total += rc.Count
+	}
+	return total
+}
+
+// GetPostStats fetches engagement counts for a post. Impressions and unique
+// views are fetched too when LinkedIn allows it, which is only for the
+// member's own posts; otherwise HasViews is false. A failure fetching views
+// never fails the call: the engagement counts are still returned, with
+// ViewsError set unless the views were simply not ours to see.
+func (c *Client) GetPostStats(ctx context.Context, urn string) (*PostStats, error) {
+	// URL encode the URN.
+	encodedURN := url.PathEscape(urn)
+
+	var counts socialActivityCounts
+	if err := c.Get(ctx, "/voyagerSocialDashSocialActivityCounts/"+encodedURN, nil, &counts); err != nil {
+		return nil, err
+	}
+
+	stats := &PostStats{
+		URN:       urn,
+		Likes:     counts.likes(),
+		Comments:  counts.NumComments,
+		Shares:    counts.NumShares,
+		FetchedAt: time.Now(),
+	}
+	if len(counts.ReactionTypeCounts) > 0 {
+		stats.Reactions = make(map[string]int, len(counts.ReactionTypeCounts))
+		for _, rc := range counts.ReactionTypeCounts {
+			stats.Reactions[rc.ReactionType] = rc.Count
+		}
+	}
+
+	impressions, unique, err := c.getPostViews(ctx, urn)
+	var apiErr *Error
+	switch {
+	case err == nil:
+		stats.Impressions, stats.UniqueViews, stats.HasViews = impressions, unique, true
+	case errors.As(err, &apiErr) && (apiErr.Code == ErrCodeForbidden || apiErr.Code == ErrCodeNotFound):
+		// Not our post; views are private to the author.
+	default:
+		stats.ViewsError = err.Error()
+	}
+
+	return stats, nil
+}
+
+// getPostViews fetches impression counts from the author-only analytics
+// endpoint.
+func (c *Client) getPostViews(ctx context.Context, urn string) (impressions, unique int, err error) {
+	query := url.Values{
+		"q":         {"updateAnalytics"},
+		"updateUrn": {urn},
+	}
+
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/voyagerFeedDashUpdateAnalytics", query, &result); err != nil {
+		return 0, 0, err
+	}
+
+	for _, raw := range append([]json.RawMessage{result.Data}, result.Included...) {
+		var entity struct {
+			NumImpressions       int `json:"numImpressions"`
+			NumUniqueImpressions int `json:"numUniqueImpressions"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil {
+			continue
+		}
+		if entity.NumImpressions > 0 || entity.NumUniqueImpressions > 0 {
+			return entity.NumImpressions, entity.NumUniqueImpressions, nil
+		}
+	}
+	return 0, 0, nil
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pp/lnk/internal/urn"
+)
+
+// Typeahead kinds understood by the hitsV2 endpoint.
+const (
+	TypeaheadPeople   = "PEOPLE"
+	TypeaheadCompany  = "COMPANY"
+	TypeaheadGeo      = "GEO"
+	TypeaheadSchool   = "SCHOOL"
+	TypeaheadSkill    = "SKILL"
+	TypeaheadTitle    = "TITLE"
+	TypeaheadIndustry = "INDUSTRY"
+)
+
+// typeaheadKinds maps the names accepted by ParseTypeaheadKind to kinds.
+var typeaheadKinds = map[string]string{
+	"people":     TypeaheadPeople,
+	"person":     TypeaheadPeople,
+	"companies":  TypeaheadCompany,
+	"company":    TypeaheadCompany,
+	"geos":       TypeaheadGeo,
+	"geo":        TypeaheadGeo,
+	"locations":  TypeaheadGeo,
+	"location":   TypeaheadGeo,
+	"schools":    TypeaheadSchool,
+	"school":     TypeaheadSchool,
+	"skills":     TypeaheadSkill,
+	"skill":      TypeaheadSkill,
+	"titles":     TypeaheadTitle,
+	"title":      TypeaheadTitle,
+	"industries": TypeaheadIndustry,
+	"industry":   TypeaheadIndustry,
+}
+
+// ParseTypeaheadKind converts a kind name such as "people", "companies",
+// "geo", "schools", "skills", "titles" or "industries" into a typeahead
+// kind.
+func ParseTypeaheadKind(s string) (string, error) {
+	if k, ok := typeaheadKinds[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return k, nil
+	}
+	return "", fmt.Errorf("invalid kind %q: use people, companies, geo, schools, skills, titles or industries", s)
+}
+
+// TypeaheadCache stores typeahead hits between runs. Get reports whether
+// hits for kind and text are cached.
+type TypeaheadCache interface {
+	Get(kind, text string) ([]TypeaheadHit, bool)
+	Put(kind, text string, hits []TypeaheadHit)
+}
+
+// SetTypeaheadCache makes Typeahead, and the filter lookups built on it,
+// consult cache before calling the API. A nil cache disables caching.
+func (c *Client) SetTypeaheadCache(cache TypeaheadCache) {
+	c.typeaheadCache = cache
+}
+
+// TypeaheadHit is a single typeahead suggestion. ID is the ID part of URN,
+// which is numeric for everything but people.
+type TypeaheadHit struct {
+	URN     string `json:"urn"`
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Subtext string `json:"subtext,omitempty"`
+}
+
+// typeaheadEntity is a hit as returned by the hitsV2 endpoint.
+type typeaheadEntity struct {
+	Text      textView `json:"text"`
+	Subtext   textView `json:"subtext"`
+	TargetURN string   `json:"targetUrn"`
+	ObjectURN string   `json:"objectUrn"`
+}
+
+// hit converts e to a TypeaheadHit. Profile URNs are returned in
+// fsd_profile form. It reports false if e has no usable URN.
+func (e *typeaheadEntity) hit() (TypeaheadHit, bool) {
+	for _, s := range []string{e.TargetURN, e.ObjectURN} {
+		u, err := urn.Parse(s)
+		if err != nil || u.ID == "" {
+			continue
+		}
+		if p, ok := u.Profile(); ok {
+			u = p
+		}
+		return TypeaheadHit{URN: u.String(), ID: u.ID, Text: e.Text.Text, Subtext: e.Subtext.Text}, true
+	}
+	return TypeaheadHit{}, false
+}
+
+// Typeahead looks up suggestions of the given kind for text, best match
+// first.
+func (c *Client) Typeahead(ctx context.Context, kind, text string) ([]TypeaheadHit, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: "empty lookup text"}
+	}
+	if c.typeaheadCache != nil {
+		if hits, ok := c.typeaheadCache.Get(kind, text); ok {
+			return hits, nil
+		}
+	}
+
+	query := url.Values{
+		"keywords": {text},
+		"origin":   {"OTHER"},
+		"q":        {"type"},
+		"type":     {kind},
+	}
+	if kind == TypeaheadGeo {
+		query.Set("queryContext", "List(geoVersion->3,bingGeoSubTypeFilters->MARKET_AREA|COUNTRY_REGION|ADMIN_DIVISION_1|CITY)")
+	}
+
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/typeahead/hitsV2", query, &result); err != nil {
+		return nil, err
+	}
+	hits := parseTypeaheadHits(&result)
+
+	if c.typeaheadCache != nil {
+		c.typeaheadCache.Put(kind, text, hits)
+	}
+	return hits, nil
+}
+
+// parseTypeaheadHits reads hits from either the plain response, where they
+// are inline in data.elements, or the normalized one, where they are
+// included entities.
+func parseTypeaheadHits(result *VoyagerResponse) []TypeaheadHit {
+	hits := []TypeaheadHit{}
+
+	var data struct {
+		Elements []typeaheadEntity `json:"elements"`
+	}
+	if len(result.Data) > 0 && json.Unmarshal(result.Data, &data) == nil {
+		for _, e := range data.Elements {
+			if h, ok := e.hit(); ok {
+				hits = append(hits, h)
+			}
+		}
+	}
+
+	for _, raw := range result.Included {
+		var e struct {
+			Type string `json:"$type"`
+			typeaheadEntity
+		}
+		if json.Unmarshal(raw, &e) != nil || !strings.Contains(e.Type, "TypeaheadHit") {
+			continue
+		}
+		if h, ok := e.hit(); ok {
+			hits = append(hits, h)
+		}
+	}
+
+	return hits
+}
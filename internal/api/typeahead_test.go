@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mapTypeaheadCache is an in-memory TypeaheadCache.
+type mapTypeaheadCache map[string][]TypeaheadHit
+
+func (m mapTypeaheadCache) Get(kind, text string) ([]TypeaheadHit, bool) {
+	hits, ok := m[kind+"|"+text]
+	return hits, ok
+}
+
+func (m mapTypeaheadCache) Put(kind, text string, hits []TypeaheadHit) {
+	m[kind+"|"+text] = hits
+}
+
+func TestParseTypeaheadKind(t *testing.T) {
+	for in, want := range map[string]string{
+		"people":    TypeaheadPeople,
+		"Companies": TypeaheadCompany,
+		"location":  TypeaheadGeo,
+		"skill":     TypeaheadSkill,
+		" titles ":  TypeaheadTitle,
+	} {
+		if got, err := ParseTypeaheadKind(in); err != nil || got != want {
+			t.Errorf("ParseTypeaheadKind(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseTypeaheadKind("hashtags"); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestTypeahead(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		q := r.URL.Query()
+		if r.URL.Path != "/typeahead/hitsV2" || q.Get("type") != TypeaheadPeople || q.Get("keywords") != "jane" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"elements": [
+			{"text": {"text": "Jane Doe"}, "subtext": {"text": "Engineer at Acme"}, "targetUrn": "urn:li:fs_miniProfile:ACoAAA"},
+			{"text": {"text": "no target"}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+	cache := mapTypeaheadCache{}
+	c.SetTypeaheadCache(cache)
+
+	for range 2 {
+		hits, err := c.Typeahead(context.Background(), TypeaheadPeople, " jane ")
+		if err != nil {
+			t.Fatalf("Typeahead() error: %v", err)
+		}
+		want := TypeaheadHit{URN: "urn:li:fsd_profile:ACoAAA", ID: "ACoAAA", Text: "Jane Doe", Subtext: "Engineer at Acme"}
+		if len(hits) != 1 || hits[0] != want {
+			t.Errorf("hits = %+v", hits)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 with cache", calls)
+	}
+
+	if _, err := c.Typeahead(context.Background(), TypeaheadPeople, " "); err == nil {
+		t.Error("expected error for empty text")
+	}
+}
@@ -14,6 +14,8 @@ type Response[T any] struct {
 type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	// Err is the underlying transport error, if any.
+	Err error `json:"-"`
 }
 
 // Common error codes.
@@ -58,6 +60,17 @@ type Profile struct {
 	ProfileURL    string `json:"profileUrl,omitempty"`
 	ProfilePicURL string `json:"profilePicUrl,omitempty"`
 	PublicID      string `json:"publicId,omitempty"`
+
+	// Name is the display name. It is set on people search results, and
+	// on reactors whose profile is not included in the response.
+	Name string `json:"name,omitempty"`
+	// Set on people search results only.
+	Degree            string `json:"degree,omitempty"`
+	Premium           bool   `json:"premium,omitempty"`
+	MutualConnections int    `json:"mutualConnections,omitempty"`
+	// Hidden marks a "LinkedIn Member" placeholder for someone outside
+	// your network, whose name and profile URL are not shown.
+	Hidden bool `json:"hidden,omitempty"`
 }
 
 // Post represents a LinkedIn post.
@@ -70,15 +83,129 @@ type Post struct {
 	LikeCount    int       `json:"likeCount"`
 	CommentCount int       `json:"commentCount"`
 	ShareCount   int       `json:"shareCount"`
+	Poll         *Poll     `json:"poll,omitempty"`
+	ResharedURN  string    `json:"resharedUrn,omitempty"`
+	Reshared     *Post     `json:"reshared,omitempty"`
+	Media        []Media   `json:"media,omitempty"`
+	Article      *Article  `json:"article,omitempty"`
+}
+
+// Media types.
+const (
+	MediaImage    = "image"
+	MediaVideo    = "video"
+	MediaDocument = "document"
+)
+
+// Media is an image, video or document attached to a post.
+type Media struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	// ThumbnailURL is a still image for videos and documents.
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+}
+
+// Article is the link card shown for a URL shared in a post.
+type Article struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Subtitle    string `json:"subtitle,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"imageUrl,omitempty"`
+}
+
+// PostStats is a snapshot of a post's engagement. Impressions and unique
+// views are only available for the member's own posts.
+type PostStats struct {
+	URN         string         `json:"urn"`
+	Likes       int            `json:"likes"`
+	Comments    int            `json:"comments"`
+	Shares      int            `json:"shares"`
+	Reactions   map[string]int `json:"reactions,omitempty"`
+	Impressions int            `json:"impressions,omitempty"`
+	UniqueViews int            `json:"uniqueViews,omitempty"`
+	HasViews    bool           `json:"hasViews"`
+	// ViewsError explains why views could not be fetched when the failure
+	// was unexpected (views on other members' posts are always absent).
+	ViewsError string    `json:"viewsError,omitempty"`
+	FetchedAt  time.Time `json:"fetchedAt"`
+}
+
+// Poll represents a poll attached to a post.
+type Poll struct {
+	URN        string       `json:"urn"`
+	Question   string       `json:"question"`
+	Options    []PollOption `json:"options"`
+	TotalVotes int          `json:"totalVotes"`
+	EndsAt     time.Time    `json:"endsAt,omitempty"`
 }
 
+// PollOption is a single poll choice and its vote count.
+type PollOption struct {
+	Text      string `json:"text"`
+	VoteCount int    `json:"voteCount"`
+}
+
+// Comment represents a comment on a post. Top-level comments carry their
+// replies in Replies.
+type Comment struct {
+	URN        string    `json:"urn"`
+	ThreadURN  string    `json:"threadUrn,omitempty"`
+	ParentURN  string    `json:"parentUrn,omitempty"`
+	AuthorURN  string    `json:"authorUrn,omitempty"`
+	AuthorName string    `json:"authorName,omitempty"`
+	Text       string    `json:"text"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LikeCount  int       `json:"likeCount"`
+	ReplyCount int       `json:"replyCount"`
+	Replies    []Comment `json:"replies,omitempty"`
+}
+
+// Reaction is a member's reaction to a post or comment.
+type Reaction struct {
+	Type    string  `json:"type"`
+	Reactor Profile `json:"reactor"`
+}
+
+// Feed item types.
+const (
+	FeedItemPost    = "post"
+	FeedItemReshare = "reshare"
+)
+
 // FeedItem represents an item in the LinkedIn feed.
 type FeedItem struct {
 	URN       string    `json:"urn"`
 	Type      string    `json:"type"`
 	Post      *Post     `json:"post,omitempty"`
-	Actor     *Profile  `json:"actor,omitempty"`
+	Actor     *Actor    `json:"actor,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
+	// Reason explains why the item is in the feed when it is not simply a
+	// post from a connection, e.g. "Jane Doe likes this".
+	Reason string `json:"reason,omitempty"`
+	// Promoted is set for sponsored updates.
+	Promoted bool `json:"promoted,omitempty"`
+	// Score is assigned by local feed rules; higher is shown first.
+	Score int `json:"score,omitempty"`
+}
+
+// Actor kinds.
+const (
+	ActorMember  = "member"
+	ActorCompany = "company"
+)
+
+// Actor is the member or company a feed item is from.
+type Actor struct {
+	URN      string `json:"urn"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Headline string `json:"headline,omitempty"`
+	URL      string `json:"url,omitempty"`
+	ImageURL string `json:"imageUrl,omitempty"`
 }
 
 // Conversation represents a LinkedIn messaging conversation.
@@ -100,13 +227,58 @@ type Message struct {
 	CreatedAt  time.Time `json:"createdAt"`
 }
 
-// SearchResult represents a search result item.
+// SearchResult represents a search result item. Type is one of the
+// Search* result types and selects which of the other fields is set.
 type SearchResult struct {
 	URN     string   `json:"urn"`
 	Type    string   `json:"type"`
 	Profile *Profile `json:"profile,omitempty"`
 	Company *Company `json:"company,omitempty"`
 	Job     *Job     `json:"job,omitempty"`
+	School  *School  `json:"school,omitempty"`
+	Group   *Group   `json:"group,omitempty"`
+	Event   *Event   `json:"event,omitempty"`
+}
+
+// Search result types.
+const (
+	SearchPeople    = "PEOPLE"
+	SearchCompanies = "COMPANIES"
+	SearchSchools   = "SCHOOLS"
+	SearchGroups    = "GROUPS"
+	SearchEvents    = "EVENTS"
+	SearchContent   = "CONTENT"
+)
+
+// School represents a school or university page.
+type School struct {
+	URN           string `json:"urn"`
+	Name          string `json:"name"`
+	Location      string `json:"location,omitempty"`
+	FollowerCount string `json:"followerCount,omitempty"`
+	Description   string `json:"description,omitempty"`
+	URL           string `json:"url,omitempty"`
+}
+
+// Group represents a LinkedIn group.
+type Group struct {
+	URN         string `json:"urn"`
+	Name        string `json:"name"`
+	MemberCount string `json:"memberCount,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// Event represents a LinkedIn event. Time and Location are as LinkedIn
+// displays them, e.g. "Thu, Oct 23, 2026, 5:00 PM" and "Online".
+type Event struct {
+	URN         string `json:"urn"`
+	Name        string `json:"name"`
+	Time        string `json:"time,omitempty"`
+	Location    string `json:"location,omitempty"`
+	Attendees   string `json:"attendees,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
 }
 
 // Company represents a LinkedIn company.
@@ -125,10 +297,23 @@ type Company struct {
 
 // Job represents a LinkedIn job posting.
 type Job struct {
-	URN         string    `json:"urn"`
-	Title       string    `json:"title"`
-	CompanyName string    `json:"companyName"`
-	Location    string    `json:"location,omitempty"`
-	PostedAt    time.Time `json:"postedAt,omitempty"`
-	Description string    `json:"description,omitempty"`
+	URN            string    `json:"urn"`
+	Title          string    `json:"title"`
+	CompanyName    string    `json:"companyName"`
+	CompanyURN     string    `json:"companyUrn,omitempty"`
+	Location       string    `json:"location,omitempty"`
+	WorkplaceType  string    `json:"workplaceType,omitempty"`
+	PostedAt       time.Time `json:"postedAt,omitempty"`
+	Description    string    `json:"description,omitempty"`
+	ApplicantCount int       `json:"applicantCount,omitempty"`
+	ApplyURL       string    `json:"applyUrl,omitempty"`
+	EasyApply      bool      `json:"easyApply,omitempty"`
+	URL            string    `json:"url,omitempty"`
 }
+
+// Workplace types.
+const (
+	WorkplaceOnSite = "on-site"
+	WorkplaceRemote = "remote"
+	WorkplaceHybrid = "hybrid"
+)
@@ -3,10 +3,15 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/pp/lnk/internal/urn"
 )
 
 // VoyagerResponse wraps LinkedIn's Voyager API response format.
@@ -66,32 +71,120 @@ func (c *Client) GetProfile(ctx context.Context, publicID string) (*Profile, err
 	return parseProfileFromResponse(&result)
 }
 
-// GetProfileByURN fetches a profile by URN.
-func (c *Client) GetProfileByURN(ctx context.Context, urn string) (*Profile, error) {
-	// Extract the member ID from URN.
-	// URN format: urn:li:member:123456 or urn:li:fsd_profile:ACoAAAxxxxxx
-	parts := strings.Split(urn, ":")
-	if len(parts) < 4 {
+// GetProfileByURN fetches a profile by URN. Member URNs
+// (urn:li:member:123) and profile-ID URNs (urn:li:fsd_profile:ACoAA...) are
+// both accepted.
+func (c *Client) GetProfileByURN(ctx context.Context, s string) (*Profile, error) {
+	u, err := urn.Parse(s)
+	if err != nil {
+		return nil, &Error{
+			Code:    ErrCodeInvalidInput,
+			Message: err.Error(),
+		}
+	}
+	if u.Kind() != urn.KindProfile || u.IsComposite() {
 		return nil, &Error{
 			Code:    ErrCodeInvalidInput,
-			Message: fmt.Sprintf("invalid URN format: %s", urn),
+			Message: fmt.Sprintf("not a profile URN: %s", s),
 		}
 	}
 
-	memberID := parts[len(parts)-1]
+	var result VoyagerResponse
+	if err := c.Get(ctx, "/identity/dash/profiles", profileQuery(u), &result); err != nil {
+		return nil, err
+	}
 
-	// Use the profile API with URN.
+	return parseProfileFromResponse(&result)
+}
+
+// profileQuery builds the profile lookup query for a profile URN. Member
+// URNs are looked up by URN; the others carry a profile ID usable as a
+// member identity.
+func profileQuery(u urn.URN) url.Values {
 	query := url.Values{}
-	query.Set("q", "memberIdentity")
-	query.Set("memberIdentity", memberID)
+	if u.Type == urn.TypeMember {
+		query.Set("q", "memberUrn")
+		query.Set("memberUrn", u.String())
+	} else {
+		query.Set("q", "memberIdentity")
+		query.Set("memberIdentity", u.ID)
+	}
 	query.Set("decorationId", "com.linkedin.voyager.dash.deco.identity.profile.WebTopCardCore-19")
+	return query
+}
+
+// ResolveProfileURN resolves a profile URN, profile URL or username to a
+// profile URN, looking up vanity names through the API.
+func (c *Client) ResolveProfileURN(ctx context.Context, s string) (string, error) {
+	s = strings.TrimSpace(s)
+	publicID := s
+	if strings.ContainsAny(s, ":/") {
+		ref, err := ParseRef(s)
+		if err != nil {
+			return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+		}
+		if ref.Kind != RefProfile {
+			return "", &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("expected a profile, got a %s: %s", ref.Kind, s)}
+		}
+		if ref.URN != "" {
+			return ref.URN, nil
+		}
+		publicID = ref.PublicID
+	}
 
+	profile, err := c.GetProfile(ctx, publicID)
+	if err != nil {
+		return "", err
+	}
+	if profile.URN == "" {
+		return "", &Error{Code: ErrCodeNotFound, Message: "could not find profile URN for " + s}
+	}
+	return profile.URN, nil
+}
+
+// ResolveCompanyURN resolves a company URN, company page URL or vanity name
+// to a company URN, looking up vanity names through the API.
+func (c *Client) ResolveCompanyURN(ctx context.Context, s string) (string, error) {
+	name, err := companyFeedName(s)
+	if err != nil {
+		return "", err
+	}
+	if reNumericID.MatchString(name) {
+		return "urn:li:fsd_company:" + name, nil
+	}
+
+	query := url.Values{
+		"q":             {"universalName"},
+		"universalName": {name},
+	}
 	var result VoyagerResponse
-	if err := c.Get(ctx, "/identity/dash/profiles", query, &result); err != nil {
-		return nil, err
+	if err := c.Get(ctx, "/organization/companies", query, &result); err != nil {
+		return "", err
 	}
 
-	return parseProfileFromResponse(&result)
+	id := parseCompanyIDFromResponse(&result, name)
+	if id == "" {
+		return "", &Error{Code: ErrCodeNotFound, Message: "could not find company URN for " + s}
+	}
+	return "urn:li:fsd_company:" + id, nil
+}
+
+// parseCompanyIDFromResponse returns the numeric ID of the company with the
+// given universal name, or "".
+func parseCompanyIDFromResponse(resp *VoyagerResponse, name string) string {
+	for _, raw := range resp.Included {
+		var entity struct {
+			EntityURN     string `json:"entityUrn"`
+			UniversalName string `json:"universalName"`
+		}
+		if err := json.Unmarshal(raw, &entity); err != nil || !strings.EqualFold(entity.UniversalName, name) {
+			continue
+		}
+		if u, err := urn.Parse(entity.EntityURN); err == nil && u.Kind() == urn.KindCompany && reNumericID.MatchString(u.ID) {
+			return u.ID
+		}
+	}
+	return ""
 }
 
 // parseProfileFromResponse extracts a Profile from a Voyager response.
@@ -310,6 +403,14 @@ func (c *Client) GetFeed(ctx context.Context, opts *FeedOptions) ([]FeedItem, er
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var apiErr *Error
+	if errors.As(lastErr, &apiErr) && (apiErr.Code == ErrCodeRateLimited || apiErr.Code == ErrCodeAuthExpired) {
+		return nil, lastErr
+	}
+
 	if lastErr != nil {
 		// Provide helpful error message about LinkedIn API changes.
 		return nil, &Error{
@@ -321,100 +422,345 @@ func (c *Client) GetFeed(ctx context.Context, opts *FeedOptions) ([]FeedItem, er
 	return []FeedItem{}, nil
 }
 
-// parseFeedFromResponse extracts feed items from a Voyager response.
-func parseFeedFromResponse(resp *VoyagerResponse) ([]FeedItem, error) {
-	if resp == nil {
-		return nil, &Error{
-			Code:    ErrCodeServerError,
-			Message: "empty response",
-		}
+// feedPageSize is the page size used when paging hashtag and company feeds.
+const feedPageSize = 20
+
+var reHashtag = regexp.MustCompile(`^[\p{L}\p{N}_]+$`)
+
+// HashtagURN returns the hashtag URN for a tag given as "golang", "#golang"
+// or "urn:li:hashtag:golang".
+func HashtagURN(tag string) (string, error) {
+	name := strings.TrimSpace(tag)
+	name = strings.TrimPrefix(name, "urn:li:hashtag:")
+	name = strings.TrimPrefix(name, "#")
+	if !reHashtag.MatchString(name) {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("invalid hashtag: %q", tag)}
 	}
+	return "urn:li:hashtag:" + strings.ToLower(name), nil
+}
 
-	var items []FeedItem
+// GetHashtagFeed fetches recent updates tagged with a hashtag, newest
+// first, paging until opts.Limit items are collected or the feed has no
+// more.
+func (c *Client) GetHashtagFeed(ctx context.Context, hashtag string, opts *FeedOptions) ([]FeedItem, error) {
+	tag, err := HashtagURN(hashtag)
+	if err != nil {
+		return nil, err
+	}
+	return c.pageFeed(ctx, "/feed/updatesV2", url.Values{
+		"q":       {"hashtagFeed"},
+		"hashtag": {tag},
+	}, opts)
+}
 
-	// Feed items are typically in the included array.
-	for _, raw := range resp.Included {
-		var entity map[string]json.RawMessage
-		if err := json.Unmarshal(raw, &entity); err != nil {
-			continue
+// GetCompanyFeed fetches a company page's updates, newest first, paging
+// until opts.Limit items are collected or the page has no more. The company
+// is its vanity name ("acme"), page URL or URN.
+func (c *Client) GetCompanyFeed(ctx context.Context, company string, opts *FeedOptions) ([]FeedItem, error) {
+	name, err := companyFeedName(company)
+	if err != nil {
+		return nil, err
+	}
+	return c.pageFeed(ctx, "/feed/updatesV2", url.Values{
+		"q":                    {"companyFeedByUniversalName"},
+		"companyUniversalName": {name},
+		"moduleKey":            {"member-share"},
+	}, opts)
+}
+
+// companyFeedName returns the identifier the company feed is keyed by: the
+// vanity name, or the numeric ID which LinkedIn accepts in its place.
+func companyFeedName(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s != "" && !strings.ContainsAny(s, ":/.") {
+		return s, nil
+	}
+
+	ref, err := ParseRef(s)
+	if err != nil {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+	}
+	// School pages are company pages too and share their feed.
+	if ref.Kind != RefCompany && ref.Kind != RefSchool {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf("expected a company, got a %s: %s", ref.Kind, s)}
+	}
+	if ref.PublicID != "" {
+		return ref.PublicID, nil
+	}
+	u, err := urn.Parse(ref.URN)
+	if err != nil {
+		return "", &Error{Code: ErrCodeInvalidInput, Message: err.Error()}
+	}
+	return u.ID, nil
+}
+
+// pageFeed pages through a feed endpoint until opts.Limit items are
+// collected or the endpoint returns nothing new.
+func (c *Client) pageFeed(ctx context.Context, path string, base url.Values, opts *FeedOptions) ([]FeedItem, error) {
+	if opts == nil {
+		opts = &FeedOptions{Limit: 10}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+
+	items := []FeedItem{}
+	seen := make(map[string]bool)
+	start := opts.Start
+	for len(items) < opts.Limit {
+		count := min(feedPageSize, opts.Limit-len(items))
+		query := url.Values{}
+		for k, v := range base {
+			query[k] = v
 		}
+		query.Set("count", fmt.Sprintf("%d", count))
+		query.Set("start", fmt.Sprintf("%d", start))
 
-		// Look for update entities.
-		if typeField, ok := entity["$type"]; ok {
-			var typeName string
-			if err := json.Unmarshal(typeField, &typeName); err == nil {
-				if strings.Contains(typeName, "Update") || strings.Contains(typeName, "Activity") {
-					item, err := parseFeedItem(raw)
-					if err == nil && item != nil {
-						items = append(items, *item)
-					}
-				}
+		var result VoyagerResponse
+		if err := c.Get(ctx, path, query, &result); err != nil {
+			return nil, err
+		}
+
+		page, err := parseFeedFromResponse(&result)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, item := range page {
+			if seen[item.URN] {
+				continue
 			}
+			seen[item.URN] = true
+			items = append(items, item)
+			added++
+			if len(items) == opts.Limit {
+				break
+			}
+		}
+
+		if added == 0 || (result.Paging != nil && result.Paging.Total > 0 && start+count >= result.Paging.Total) {
+			break
 		}
+		start += count
 	}
 
 	return items, nil
 }
 
-// parseFeedItem parses a single feed item.
-func parseFeedItem(data json.RawMessage) (*FeedItem, error) {
-	var entity struct {
-		EntityURN string `json:"entityUrn"`
-		Actor     struct {
-			URN  string `json:"urn"`
-			Name struct {
-				Text string `json:"text"`
-			} `json:"name"`
-		} `json:"actor"`
-		Commentary struct {
-			Text struct {
-				Text string `json:"text"`
-			} `json:"text"`
-		} `json:"commentary"`
-		SocialDetail struct {
-			URN           string `json:"urn"`
-			TotalLikes    int    `json:"totalSocialActivityCounts,omitempty"`
-			LikesCount    int    `json:"likes,omitempty"`
-			CommentsCount int    `json:"comments,omitempty"`
-		} `json:"socialDetail"`
-		CreatedAt int64 `json:"createdAt"`
+// activityURN returns the activity URN for an activity or a feed update URN
+// such as urn:li:fs_updateV2:(urn:li:activity:123,MAIN_FEED,...), or "".
+func activityURN(updateURN string) string {
+	u, err := urn.Parse(updateURN)
+	if err != nil {
+		return ""
+	}
+	if a, ok := u.Activity(); ok {
+		return a.String()
 	}
+	return ""
+}
 
-	if err := json.Unmarshal(data, &entity); err != nil {
-		return nil, err
+// activityTime recovers the creation time encoded in an activity ID, whose
+// top 41 bits are a Unix timestamp in milliseconds. It returns the zero
+// time if the URN has no activity ID.
+func activityTime(updateURN string) time.Time {
+	a := activityURN(updateURN)
+	if a == "" {
+		return time.Time{}
 	}
+	id, err := strconv.ParseUint(urn.MustParse(a).ID, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(id >> 22))
+}
+
+// pollComponent is the poll block of a feed update's content.
+type pollComponent struct {
+	Poll struct {
+		EntityURN string `json:"entityUrn"`
+		Question  struct {
+			Text string `json:"text"`
+		} `json:"question"`
+		PollSummary struct {
+			UniqueVotersCount int   `json:"uniqueVotersCount"`
+			EndsAt            int64 `json:"endsAt"`
+		} `json:"pollSummary"`
+		PollOptions []struct {
+			Option struct {
+				Text string `json:"text"`
+			} `json:"option"`
+			VoteCount int `json:"voteCount"`
+		} `json:"pollOptions"`
+	} `json:"poll"`
+}
 
-	if entity.EntityURN == "" {
-		return nil, fmt.Errorf("no URN in feed item")
+// toPoll converts a decoded poll component into a Poll.
+func (pc *pollComponent) toPoll() *Poll {
+	poll := &Poll{
+		URN:        pc.Poll.EntityURN,
+		Question:   pc.Poll.Question.Text,
+		TotalVotes: pc.Poll.PollSummary.UniqueVotersCount,
+		Options:    []PollOption{},
+	}
+	if pc.Poll.PollSummary.EndsAt > 0 {
+		poll.EndsAt = time.UnixMilli(pc.Poll.PollSummary.EndsAt)
+	}
+	for _, o := range pc.Poll.PollOptions {
+		poll.Options = append(poll.Options, PollOption{
+			Text:      o.Option.Text,
+			VoteCount: o.VoteCount,
+		})
+		if pc.Poll.PollSummary.UniqueVotersCount == 0 {
+			poll.TotalVotes += o.VoteCount
+		}
 	}
+	return poll
+}
+
+// MemberPostsOptions configures member post listing.
+type MemberPostsOptions struct {
+	Limit int
+	Start int
+}
+
+const (
+	// memberPostsPageSize is the page size used when listing member posts.
+	memberPostsPageSize = 20
+	// maxMemberPostsPages bounds paging when pages yield no posts.
+	maxMemberPostsPages = 50
+)
 
-	item := &FeedItem{
-		URN:  entity.EntityURN,
-		Type: "update",
+// GetMemberPosts fetches a member's recent posts, newest first, paging
+// until opts.Limit posts are collected or the member has no more.
+func (c *Client) GetMemberPosts(ctx context.Context, profileURN string, opts *MemberPostsOptions) ([]Post, error) {
+	if opts == nil {
+		opts = &MemberPostsOptions{Limit: 10}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
 	}
 
-	if entity.Commentary.Text.Text != "" {
-		item.Post = &Post{
-			URN:  entity.EntityURN,
-			Text: entity.Commentary.Text.Text,
+	posts := []Post{}
+	seen := make(map[string]bool)
+	seenUpdates := make(map[string]bool)
+	start := opts.Start
+	for page := 0; len(posts) < opts.Limit && page < maxMemberPostsPages; page++ {
+		count := min(memberPostsPageSize, opts.Limit-len(posts))
+		query := url.Values{
+			"q":          {"memberShareFeed"},
+			"profileUrn": {profileURN},
+			"moduleKey":  {"member-shares:phone"},
+			"count":      {fmt.Sprintf("%d", count)},
+			"start":      {fmt.Sprintf("%d", start)},
+		}
+
+		var result VoyagerResponse
+		if err := c.Get(ctx, "/identity/profileUpdatesV2", query, &result); err != nil {
+			return nil, err
+		}
+
+		items, err := parseFeedFromResponse(&result)
+		if err != nil {
+			return nil, err
+		}
+
+		// A page may hold only reshares without commentary, which yield no
+		// posts, so stop on an empty raw page or one that repeats earlier
+		// updates, not on a page that added no posts.
+		fresh := 0
+		for _, item := range items {
+			if seenUpdates[item.URN] {
+				continue
+			}
+			seenUpdates[item.URN] = true
+			fresh++
+			if item.Post == nil || seen[item.Post.URN] {
+				continue
+			}
+			seen[item.Post.URN] = true
+			posts = append(posts, *item.Post)
+			if len(posts) == opts.Limit {
+				break
+			}
 		}
-	}
 
-	if entity.Actor.Name.Text != "" {
-		item.Actor = &Profile{
-			URN:       entity.Actor.URN,
-			FirstName: entity.Actor.Name.Text,
+		if len(result.Included) == 0 || (len(items) > 0 && fresh == 0) ||
+			(result.Paging != nil && result.Paging.Total > 0 && start+count >= result.Paging.Total) {
+			break
 		}
+		start += count
 	}
 
-	return item, nil
+	return posts, nil
+}
+
+// Post visibility values.
+const (
+	VisibilityAnyone      = "anyone"
+	VisibilityConnections = "connections"
+)
+
+// PostOptions configures post creation.
+type PostOptions struct {
+	// Visibility is VisibilityAnyone (default) or VisibilityConnections.
+	Visibility string
 }
 
-// CreatePost creates a new LinkedIn post.
+// CreatePost creates a new LinkedIn post visible to anyone.
 func (c *Client) CreatePost(ctx context.Context, text string) (*Post, error) {
+	return c.CreatePostWithOptions(ctx, text, nil)
+}
+
+// CreatePostWithOptions creates a new LinkedIn post with the given options.
+func (c *Client) CreatePostWithOptions(ctx context.Context, text string, opts *PostOptions) (*Post, error) {
+	payload, err := buildSharePayload(text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := c.createShare(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	c.audit(ctx, &Mutation{Operation: OpCreatePost, Target: status.URN, Content: text})
+
+	return &Post{
+		URN:  status.URN,
+		Text: text,
+	}, nil
+}
+
+// shareStatus is the status block returned by the normShares endpoint.
+type shareStatus struct {
+	URN      string `json:"urn"`
+	UpdateV2 string `json:"*updateV2"`
+	Poll     string `json:"*poll"`
+}
+
+// buildSharePayload builds the normShares request body shared by all post
+// types.
+func buildSharePayload(text string, opts *PostOptions) (map[string]any, error) {
+	if opts == nil {
+		opts = &PostOptions{}
+	}
+
+	var connectionsOnly bool
+	switch opts.Visibility {
+	case "", VisibilityAnyone:
+	case VisibilityConnections:
+		connectionsOnly = true
+	default:
+		return nil, &Error{
+			Code:    ErrCodeInvalidInput,
+			Message: fmt.Sprintf("invalid visibility %q (use %s or %s)", opts.Visibility, VisibilityAnyone, VisibilityConnections),
+		}
+	}
+
 	// Use the Voyager content creation endpoint.
-	payload := map[string]any{
-		"visibleToConnectionsOnly":  false,
+	return map[string]any{
+		"visibleToConnectionsOnly":  connectionsOnly,
 		"externalAudienceProviders": []any{},
 		"commentaryV2": map[string]any{
 			"text":       text,
@@ -423,14 +769,14 @@ func (c *Client) CreatePost(ctx context.Context, text string) (*Post, error) {
 		"origin":                 "FEED",
 		"allowedCommentersScope": "ALL",
 		"postState":              "PUBLISHED",
-	}
+	}, nil
+}
 
+// createShare posts a normShares payload and returns the resulting status.
+func (c *Client) createShare(ctx context.Context, payload map[string]any) (*shareStatus, error) {
 	var result struct {
 		Data struct {
-			Status struct {
-				URN      string `json:"urn"`
-				UpdateV2 string `json:"*updateV2"`
-			} `json:"status"`
+			Status shareStatus `json:"status"`
 		} `json:"data"`
 	}
 
@@ -438,9 +784,157 @@ func (c *Client) CreatePost(ctx context.Context, text string) (*Post, error) {
 		return nil, err
 	}
 
+	return &result.Data.Status, nil
+}
+
+// Reshare reposts an existing post. With empty commentary it is a plain
+// repost; otherwise the commentary is shown above the original post.
+func (c *Client) Reshare(ctx context.Context, urn, commentary string) (*Post, error) {
+	return c.ReshareWithOptions(ctx, urn, commentary, nil)
+}
+
+// ReshareWithOptions reposts an existing post with the given options.
+func (c *Client) ReshareWithOptions(ctx context.Context, urn, commentary string, opts *PostOptions) (*Post, error) {
+	if urn == "" {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: "post URN is required"}
+	}
+
+	payload, err := buildSharePayload(commentary, opts)
+	if err != nil {
+		return nil, err
+	}
+	payload["parentUrn"] = urn
+
+	status, err := c.createShare(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	c.audit(ctx, &Mutation{Operation: OpReshare, Target: status.URN, Parent: urn, Content: commentary})
+
 	return &Post{
-		URN:  result.Data.Status.URN,
+		URN:         status.URN,
+		Text:        commentary,
+		ResharedURN: urn,
+	}, nil
+}
+
+// Poll durations accepted by LinkedIn.
+const (
+	PollDurationOneDay    = "ONE_DAY"
+	PollDurationThreeDays = "THREE_DAYS"
+	PollDurationOneWeek   = "ONE_WEEK"
+	PollDurationTwoWeeks  = "TWO_WEEKS"
+)
+
+// Poll limits enforced by LinkedIn.
+const (
+	PollMinOptions        = 2
+	PollMaxOptions        = 4
+	PollMaxQuestionLength = 140
+	PollMaxOptionLength   = 30
+)
+
+// PollOptions configures poll creation.
+type PollOptions struct {
+	PostOptions
+
+	// Question is the poll question.
+	Question string
+	// Options are the poll choices, in display order.
+	Options []string
+	// Duration is one of the PollDuration constants (default one week).
+	Duration string
+}
+
+// CreatePoll creates a post with an attached poll. The text is the post
+// commentary and may be empty.
+func (c *Client) CreatePoll(ctx context.Context, text string, opts *PollOptions) (*Post, error) {
+	if opts == nil {
+		return nil, &Error{Code: ErrCodeInvalidInput, Message: "poll options are required"}
+	}
+
+	pollContent, err := buildPollContent(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := buildSharePayload(text, &opts.PostOptions)
+	if err != nil {
+		return nil, err
+	}
+	payload["media"] = []any{pollContent}
+
+	status, err := c.createShare(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	c.audit(ctx, &Mutation{
+		Operation: OpCreatePoll,
+		Target:    status.URN,
+		Content:   strings.Join(append([]string{text, opts.Question}, opts.Options...), "\n"),
+	})
+
+	poll := &Poll{
+		URN:      status.Poll,
+		Question: opts.Question,
+	}
+	for _, o := range opts.Options {
+		poll.Options = append(poll.Options, PollOption{Text: o})
+	}
+
+	return &Post{
+		URN:  status.URN,
 		Text: text,
+		Poll: poll,
+	}, nil
+}
+
+// buildPollContent validates poll options and builds the poll media entry
+// for a normShares payload.
+func buildPollContent(opts *PollOptions) (map[string]any, error) {
+	invalid := func(format string, args ...any) error {
+		return &Error{Code: ErrCodeInvalidInput, Message: fmt.Sprintf(format, args...)}
+	}
+
+	question := strings.TrimSpace(opts.Question)
+	if question == "" {
+		return nil, invalid("poll question cannot be empty")
+	}
+	if n := len([]rune(question)); n > PollMaxQuestionLength {
+		return nil, invalid("poll question is %d characters; the limit is %d", n, PollMaxQuestionLength)
+	}
+
+	if len(opts.Options) < PollMinOptions || len(opts.Options) > PollMaxOptions {
+		return nil, invalid("a poll needs %d to %d options, got %d", PollMinOptions, PollMaxOptions, len(opts.Options))
+	}
+	options := make([]any, 0, len(opts.Options))
+	for _, o := range opts.Options {
+		o = strings.TrimSpace(o)
+		if o == "" {
+			return nil, invalid("poll options cannot be empty")
+		}
+		if n := len([]rune(o)); n > PollMaxOptionLength {
+			return nil, invalid("poll option %q is %d characters; the limit is %d", o, n, PollMaxOptionLength)
+		}
+		options = append(options, map[string]any{"text": o})
+	}
+
+	duration := opts.Duration
+	switch duration {
+	case "":
+		duration = PollDurationOneWeek
+	case PollDurationOneDay, PollDurationThreeDays, PollDurationOneWeek, PollDurationTwoWeeks:
+	default:
+		return nil, invalid("invalid poll duration %q", opts.Duration)
+	}
+
+	return map[string]any{
+		"category": "POLL",
+		"pollContent": map[string]any{
+			"question": question,
+			"options":  options,
+			"duration": duration,
+		},
 	}, nil
 }
 
@@ -448,7 +942,11 @@ func (c *Client) CreatePost(ctx context.Context, text string) (*Post, error) {
 func (c *Client) DeletePost(ctx context.Context, urn string) error {
 	// URL encode the URN.
 	encodedURN := url.PathEscape(urn)
-	return c.Delete(ctx, "/contentcreation/normShares/"+encodedURN)
+	if err := c.Delete(ctx, "/contentcreation/normShares/"+encodedURN); err != nil {
+		return err
+	}
+	c.audit(ctx, &Mutation{Operation: OpDeletePost, Target: urn})
+	return nil
 }
 
 // GetPost fetches a post by URN.
@@ -462,9 +960,12 @@ func (c *Client) GetPost(ctx context.Context, urn string) (*Post, error) {
 	}
 
 	// Parse the post from response.
-	for _, raw := range result.Included {
-		item, err := parseFeedItem(raw)
-		if err == nil && item != nil && item.Post != nil {
+	items, err := parseFeedFromResponse(&result)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Post != nil {
 			return item.Post, nil
 		}
 	}
@@ -479,6 +980,9 @@ func (c *Client) GetPost(ctx context.Context, urn string) (*Post, error) {
 type SearchOptions struct {
 	Limit int
 	Start int
+	// Filters are extra query parameters, such as those returned by
+	// ResolvePeopleFilters.
+	Filters []SearchFilter
 }
 
 // searchResult is the common response structure for GraphQL search queries.
@@ -487,197 +991,43 @@ type searchResult struct {
 	Included []json.RawMessage `json:"included"`
 }
 
-// buildSearchPath constructs the GraphQL search path for a given result type.
-func buildSearchPath(query string, resultType string, start int) string {
+// buildSearchPath constructs the GraphQL search path for a given result type
+// and filters.
+func buildSearchPath(query string, resultType string, start int, filters []SearchFilter) string {
 	encodedQuery := url.QueryEscape(query)
+	params := append([]SearchFilter{{Key: "resultType", Values: []string{resultType}}}, filters...)
 	return fmt.Sprintf(
-		"/graphql?variables=(start:%d,origin:GLOBAL_SEARCH_HEADER,query:(keywords:%s,flagshipSearchIntent:SEARCH_SRP,queryParameters:List((key:resultType,value:List(%s))),includeFiltersInResponse:false))&queryId=voyagerSearchDashClusters.b0928897b71bd00a5a7291755dcd64f0",
+		"/graphql?variables=(start:%d,origin:%s,query:(keywords:%s,flagshipSearchIntent:SEARCH_SRP,queryParameters:%s,includeFiltersInResponse:false))&queryId=voyagerSearchDashClusters.b0928897b71bd00a5a7291755dcd64f0",
 		start,
+		searchOrigin(filters),
 		encodedQuery,
-		resultType,
+		encodeSearchFilters(params),
 	)
 }
 
 // SearchPeople searches for people on LinkedIn.
 func (c *Client) SearchPeople(ctx context.Context, query string, opts *SearchOptions) ([]Profile, error) {
-	if opts == nil {
-		opts = &SearchOptions{Limit: 10}
-	}
-	if opts.Limit <= 0 {
-		opts.Limit = 10
-	}
-
-	var result searchResult
-	if err := c.Get(ctx, buildSearchPath(query, "PEOPLE", opts.Start), nil, &result); err != nil {
+	results, err := c.Search(ctx, SearchPeople, query, opts)
+	if err != nil {
 		return nil, err
 	}
-
-	return parseSearchPeopleResults(result.Included)
-}
-
-// parseSearchPeopleResults extracts profiles from search results.
-func parseSearchPeopleResults(included []json.RawMessage) ([]Profile, error) {
-	var profiles []Profile
-
-	for _, raw := range included {
-		var entity struct {
-			Type  string `json:"$type"`
-			Title *struct {
-				Text string `json:"text"`
-			} `json:"title"`
-			PrimarySubtitle *struct {
-				Text string `json:"text"`
-			} `json:"primarySubtitle"`
-			SecondarySubtitle *struct {
-				Text string `json:"text"`
-			} `json:"secondarySubtitle"`
-			NavigationURL string `json:"navigationUrl"`
-			TrackingURN   string `json:"trackingUrn"`
-			BadgeText     *struct {
-				Text string `json:"text"`
-			} `json:"badgeText"`
-		}
-
-		if err := json.Unmarshal(raw, &entity); err != nil {
-			continue
-		}
-
-		// Only process EntityResultViewModel for people.
-		if entity.Type != "com.linkedin.voyager.dash.search.EntityResultViewModel" {
-			continue
-		}
-
-		// Check if it's a person (trackingUrn contains "member").
-		if !strings.Contains(entity.TrackingURN, "member") {
-			continue
-		}
-
-		profile := Profile{
-			URN:        entity.TrackingURN,
-			ProfileURL: entity.NavigationURL,
-		}
-
-		if entity.Title != nil {
-			// Parse first and last name from title.
-			parts := strings.SplitN(entity.Title.Text, " ", 2)
-			if len(parts) >= 1 {
-				profile.FirstName = parts[0]
-			}
-			if len(parts) >= 2 {
-				profile.LastName = parts[1]
-			}
-		}
-
-		if entity.PrimarySubtitle != nil {
-			profile.Headline = entity.PrimarySubtitle.Text
-		}
-
-		if entity.SecondarySubtitle != nil {
-			profile.Location = entity.SecondarySubtitle.Text
-		}
-
-		// Extract public ID from URL.
-		if entity.NavigationURL != "" {
-			if idx := strings.Index(entity.NavigationURL, "/in/"); idx != -1 {
-				publicID := entity.NavigationURL[idx+4:]
-				if qIdx := strings.Index(publicID, "?"); qIdx != -1 {
-					publicID = publicID[:qIdx]
-				}
-				profile.PublicID = publicID
-			}
-		}
-
-		profiles = append(profiles, profile)
+	profiles := make([]Profile, 0, len(results))
+	for _, r := range results {
+		profiles = append(profiles, *r.Profile)
 	}
-
 	return profiles, nil
 }
 
 // SearchCompanies searches for companies on LinkedIn.
 func (c *Client) SearchCompanies(ctx context.Context, query string, opts *SearchOptions) ([]Company, error) {
-	if opts == nil {
-		opts = &SearchOptions{Limit: 10}
-	}
-	if opts.Limit <= 0 {
-		opts.Limit = 10
-	}
-
-	var result searchResult
-	if err := c.Get(ctx, buildSearchPath(query, "COMPANIES", opts.Start), nil, &result); err != nil {
+	results, err := c.Search(ctx, SearchCompanies, query, opts)
+	if err != nil {
 		return nil, err
 	}
-
-	return parseSearchCompanyResults(result.Included)
-}
-
-// parseSearchCompanyResults extracts companies from search results.
-func parseSearchCompanyResults(included []json.RawMessage) ([]Company, error) {
-	var companies []Company
-
-	for _, raw := range included {
-		var entity struct {
-			Type  string `json:"$type"`
-			Title *struct {
-				Text string `json:"text"`
-			} `json:"title"`
-			PrimarySubtitle *struct {
-				Text string `json:"text"`
-			} `json:"primarySubtitle"`
-			SecondarySubtitle *struct {
-				Text string `json:"text"`
-			} `json:"secondarySubtitle"`
-			Summary *struct {
-				Text string `json:"text"`
-			} `json:"summary"`
-			NavigationURL string `json:"navigationUrl"`
-			TrackingURN   string `json:"trackingUrn"`
-		}
-
-		if err := json.Unmarshal(raw, &entity); err != nil {
-			continue
-		}
-
-		// Only process EntityResultViewModel for companies.
-		if entity.Type != "com.linkedin.voyager.dash.search.EntityResultViewModel" {
-			continue
-		}
-
-		// Check if it's a company (trackingUrn contains "company").
-		if !strings.Contains(entity.TrackingURN, "company") {
-			continue
-		}
-
-		company := Company{
-			URN:        entity.TrackingURN,
-			CompanyURL: entity.NavigationURL,
-		}
-
-		if entity.Title != nil {
-			company.Name = entity.Title.Text
-		}
-
-		if entity.PrimarySubtitle != nil {
-			// Primary subtitle contains "Industry • Location".
-			parts := strings.SplitN(entity.PrimarySubtitle.Text, " • ", 2)
-			if len(parts) >= 1 {
-				company.Industry = parts[0]
-			}
-			if len(parts) >= 2 {
-				company.Location = parts[1]
-			}
-		}
-
-		if entity.SecondarySubtitle != nil {
-			company.FollowerCount = entity.SecondarySubtitle.Text
-		}
-
-		if entity.Summary != nil {
-			company.Description = entity.Summary.Text
-		}
-
-		companies = append(companies, company)
+	companies := make([]Company, 0, len(results))
+	for _, r := range results {
+		companies = append(companies, *r.Company)
 	}
-
 	return companies, nil
 }
 
@@ -964,6 +1314,7 @@ func (c *Client) SendMessage(ctx context.Context, profileURN, text string) (*Mes
 	if err := c.Post(ctx, "/messaging/conversations", payload, &result); err != nil {
 		return nil, err
 	}
+	c.audit(ctx, &Mutation{Operation: OpSendMessage, Target: profileURN, Content: text})
 
 	return &Message{
 		SenderURN: myProfile.URN,
@@ -993,6 +1344,7 @@ func (c *Client) SendMessageToConversation(ctx context.Context, conversationURN,
 	if err := c.Post(ctx, "/messaging/conversations/"+encodedURN+"/events", payload, &result); err != nil {
 		return nil, err
 	}
+	c.audit(ctx, &Mutation{Operation: OpSendMessageToConversation, Target: conversationURN, Content: text})
 
 	return &Message{
 		Text:      text,
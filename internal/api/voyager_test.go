@@ -1,7 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -182,3 +186,453 @@ func TestVoyagerResponsePaging(t *testing.T) {
 		t.Errorf("Links count = %d, want 1", len(resp.Paging.Links))
 	}
 }
+
+func TestBuildPollContent(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         PollOptions
+		wantErr      bool
+		wantDuration string
+	}{
+		{
+			name:         "defaults to one week",
+			opts:         PollOptions{Question: "Tabs or spaces?", Options: []string{"Tabs", "Spaces"}},
+			wantDuration: PollDurationOneWeek,
+		},
+		{
+			name:         "explicit duration",
+			opts:         PollOptions{Question: "Q?", Options: []string{"A", "B", "C"}, Duration: PollDurationThreeDays},
+			wantDuration: PollDurationThreeDays,
+		},
+		{
+			name:    "too few options",
+			opts:    PollOptions{Question: "Q?", Options: []string{"A"}},
+			wantErr: true,
+		},
+		{
+			name:    "too many options",
+			opts:    PollOptions{Question: "Q?", Options: []string{"A", "B", "C", "D", "E"}},
+			wantErr: true,
+		},
+		{
+			name:    "option too long",
+			opts:    PollOptions{Question: "Q?", Options: []string{"A", "this option is far too long to fit"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty question",
+			opts:    PollOptions{Options: []string{"A", "B"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration",
+			opts:    PollOptions{Question: "Q?", Options: []string{"A", "B"}, Duration: "FOREVER"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := buildPollContent(&tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildPollContent() error: %v", err)
+			}
+
+			poll := content["pollContent"].(map[string]any)
+			if poll["duration"] != tt.wantDuration {
+				t.Errorf("duration = %v, want %s", poll["duration"], tt.wantDuration)
+			}
+			if n := len(poll["options"].([]any)); n != len(tt.opts.Options) {
+				t.Errorf("options = %d, want %d", n, len(tt.opts.Options))
+			}
+		})
+	}
+}
+
+func TestParseFeedItemPoll(t *testing.T) {
+	jsonData := `{
+		"entityUrn": "urn:li:activity:1",
+		"commentary": {"text": {"text": "Vote!"}},
+		"content": {
+			"pollComponent": {
+				"poll": {
+					"entityUrn": "urn:li:poll:9",
+					"question": {"text": "Tabs or spaces?"},
+					"pollSummary": {"endsAt": 1767225600000},
+					"pollOptions": [
+						{"option": {"text": "Tabs"}, "voteCount": 3},
+						{"option": {"text": "Spaces"}, "voteCount": 5}
+					]
+				}
+			}
+		}
+	}`
+
+	item, err := parseFeedItem(json.RawMessage(jsonData))
+	if err != nil {
+		t.Fatalf("parseFeedItem error: %v", err)
+	}
+	if item.Post == nil || item.Post.Poll == nil {
+		t.Fatal("expected post with poll")
+	}
+
+	poll := item.Post.Poll
+	if poll.URN != "urn:li:poll:9" || poll.Question != "Tabs or spaces?" {
+		t.Errorf("poll = %+v", poll)
+	}
+	if len(poll.Options) != 2 || poll.Options[1].Text != "Spaces" || poll.Options[1].VoteCount != 5 {
+		t.Errorf("Options = %+v", poll.Options)
+	}
+	if poll.TotalVotes != 8 {
+		t.Errorf("TotalVotes = %d, want 8", poll.TotalVotes)
+	}
+	if poll.EndsAt.IsZero() {
+		t.Error("EndsAt not set")
+	}
+}
+
+func TestReshare(t *testing.T) {
+	tests := []struct {
+		name       string
+		commentary string
+	}{
+		{name: "plain repost"},
+		{name: "quote repost", commentary: "Worth a read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if body["parentUrn"] != "urn:li:activity:1" {
+					t.Errorf("parentUrn = %v, want urn:li:activity:1", body["parentUrn"])
+				}
+				commentary := body["commentaryV2"].(map[string]any)
+				if commentary["text"] != tt.commentary {
+					t.Errorf("commentary = %v, want %q", commentary["text"], tt.commentary)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data": {"status": {"urn": "urn:li:share:2"}}}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(
+				WithBaseURL(server.URL),
+				WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+			)
+
+			post, err := c.Reshare(context.Background(), "urn:li:activity:1", tt.commentary)
+			if err != nil {
+				t.Fatalf("Reshare() error: %v", err)
+			}
+			if post.URN != "urn:li:share:2" || post.ResharedURN != "urn:li:activity:1" {
+				t.Errorf("post = %+v", post)
+			}
+		})
+	}
+}
+
+func TestParseFeedItemCounts(t *testing.T) {
+	jsonData := `{
+		"entityUrn": "urn:li:activity:1",
+		"commentary": {"text": {"text": "Hello"}},
+		"socialDetail": {
+			"totalSocialActivityCounts": {
+				"numComments": 4,
+				"numShares": 2,
+				"reactionTypeCounts": [
+					{"reactionType": "LIKE", "count": 7},
+					{"reactionType": "PRAISE", "count": 3}
+				]
+			}
+		}
+	}`
+
+	item, err := parseFeedItem(json.RawMessage(jsonData))
+	if err != nil {
+		t.Fatalf("parseFeedItem error: %v", err)
+	}
+	if item.Post == nil {
+		t.Fatal("expected post")
+	}
+	if item.Post.LikeCount != 10 || item.Post.CommentCount != 4 || item.Post.ShareCount != 2 {
+		t.Errorf("counts = %d/%d/%d, want 10/4/2", item.Post.LikeCount, item.Post.CommentCount, item.Post.ShareCount)
+	}
+}
+
+func TestActivityTime(t *testing.T) {
+	got := activityTime("urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,MEMBER_SHARES)")
+	if got.UnixMilli() != 1698364445927 {
+		t.Errorf("activityTime = %d, want 1698364445927", got.UnixMilli())
+	}
+	if !activityTime("urn:li:share:1").IsZero() {
+		t.Error("expected zero time for non-activity URN")
+	}
+}
+
+func TestGetMemberPosts(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("profileUrn"); got != "urn:li:fsd_profile:A" {
+			t.Errorf("profileUrn = %q", got)
+		}
+		pages++
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("start") {
+		case "0":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,MEMBER_SHARES)", "commentary": {"text": {"text": "first"}}},
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345600,MEMBER_SHARES)", "commentary": {"text": {"text": "second"}}}
+			]}`))
+		default:
+			_, _ = w.Write([]byte(`{"included": []}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	posts, err := c.GetMemberPosts(context.Background(), "urn:li:fsd_profile:A", &MemberPostsOptions{Limit: 5})
+	if err != nil {
+		t.Fatalf("GetMemberPosts() error: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("len(posts) = %d, want 2", len(posts))
+	}
+	if posts[0].URN != "urn:li:activity:7123456789012345678" || posts[0].CreatedAt.IsZero() {
+		t.Errorf("posts[0] = %+v", posts[0])
+	}
+	if pages != 2 {
+		t.Errorf("pages fetched = %d, want 2", pages)
+	}
+}
+
+func TestGetMemberPostsPageWithoutPosts(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		starts = append(starts, r.URL.Query().Get("start"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("start") {
+		case "0":
+			// A reshare without commentary yields no post of its own.
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345700,MEMBER_SHARES)"}
+			]}`))
+		case "5":
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,MEMBER_SHARES)", "commentary": {"text": {"text": "older"}}}
+			]}`))
+		default:
+			_, _ = w.Write([]byte(`{"included": []}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	posts, err := c.GetMemberPosts(context.Background(), "urn:li:fsd_profile:A", &MemberPostsOptions{Limit: 5})
+	if err != nil {
+		t.Fatalf("GetMemberPosts() error: %v", err)
+	}
+	if len(posts) != 1 || posts[0].Text != "older" {
+		t.Errorf("posts = %+v, want the post after the empty page", posts)
+	}
+	if strings.Join(starts, ",") != "0,5,10" {
+		t.Errorf("starts = %v, want [0 5 10]", starts)
+	}
+}
+
+func TestGetHashtagFeed(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "hashtagFeed" || q.Get("hashtag") != "urn:li:hashtag:golang" {
+			t.Errorf("query = %v", q)
+		}
+		starts = append(starts, q.Get("start"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("start") {
+		case "0":
+			_, _ = w.Write([]byte(`{"paging": {"start": 0, "count": 20, "total": 0}, "included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,HASHTAG_FEED)", "commentary": {"text": {"text": "first #golang"}}},
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345600,HASHTAG_FEED)", "commentary": {"text": {"text": "second #golang"}}}
+			]}`))
+		case "20":
+			// A repeated item must not be counted twice.
+			_, _ = w.Write([]byte(`{"included": [
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345600,HASHTAG_FEED)", "commentary": {"text": {"text": "second #golang"}}},
+				{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345500,HASHTAG_FEED)", "commentary": {"text": {"text": "third #golang"}}}
+			]}`))
+		default:
+			_, _ = w.Write([]byte(`{"included": []}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	items, err := c.GetHashtagFeed(context.Background(), "#GoLang", &FeedOptions{Limit: 25})
+	if err != nil {
+		t.Fatalf("GetHashtagFeed() error: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("len(items) = %d, want 3", len(items))
+	}
+	if items[2].Post == nil || items[2].Post.Text != "third #golang" {
+		t.Errorf("items[2] = %+v", items[2])
+	}
+	if strings.Join(starts, ",") != "0,20,40" {
+		t.Errorf("starts = %v, want 0,20,40", starts)
+	}
+}
+
+func TestGetCompanyFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "companyFeedByUniversalName" || q.Get("companyUniversalName") != "acme" {
+			t.Errorf("query = %v", q)
+		}
+		if q.Get("count") != "2" {
+			t.Errorf("count = %q, want 2", q.Get("count"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"included": [
+			{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345678,COMPANY_FEED)", "commentary": {"text": {"text": "launch"}}},
+			{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345600,COMPANY_FEED)", "commentary": {"text": {"text": "hiring"}}},
+			{"$type": "com.linkedin.voyager.feed.render.UpdateV2", "entityUrn": "urn:li:fs_updateV2:(urn:li:activity:7123456789012345500,COMPANY_FEED)", "commentary": {"text": {"text": "extra"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+	)
+
+	items, err := c.GetCompanyFeed(context.Background(), "https://www.linkedin.com/company/acme/", &FeedOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("GetCompanyFeed() error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("len(items) = %d, want 2", len(items))
+	}
+}
+
+func TestHashtagURN(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"golang", "urn:li:hashtag:golang"},
+		{"#GoLang", "urn:li:hashtag:golang"},
+		{"urn:li:hashtag:ai", "urn:li:hashtag:ai"},
+		{"open_source", "urn:li:hashtag:open_source"},
+		{"", ""},
+		{"two words", ""},
+	}
+	for _, tt := range tests {
+		got, err := HashtagURN(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("HashtagURN(%q) = %q, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("HashtagURN(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestCompanyFeedName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"acme", "acme"},
+		{"https://www.linkedin.com/company/acme/posts/", "acme"},
+		{"linkedin.com/company/1234", "1234"},
+		{"urn:li:company:1234", "1234"},
+		{"urn:li:fsd_profile:ACoAAA", ""},
+		{"https://www.linkedin.com/in/jane", ""},
+	}
+	for _, tt := range tests {
+		got, err := companyFeedName(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("companyFeedName(%q) = %q, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("companyFeedName(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestGetPostStatsViewsUnavailable(t *testing.T) {
+	tests := []struct {
+		name         string
+		viewsStatus  int
+		wantViewsErr bool
+	}{
+		{name: "not our post", viewsStatus: http.StatusForbidden},
+		{name: "server error", viewsStatus: http.StatusInternalServerError, wantViewsErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/voyagerFeedDashUpdateAnalytics" {
+					w.WriteHeader(tt.viewsStatus)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"numLikes": 5, "numComments": 2, "numShares": 1}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(
+				WithBaseURL(server.URL),
+				WithCredentials(&Credentials{LiAt: "test", JSessID: "session"}),
+			)
+
+			st, err := c.GetPostStats(context.Background(), "urn:li:activity:1")
+			if err != nil {
+				t.Fatalf("GetPostStats() error: %v", err)
+			}
+			if st.Likes != 5 || st.Comments != 2 || st.Shares != 1 {
+				t.Errorf("stats = %+v, want engagement counts", st)
+			}
+			if st.HasViews {
+				t.Error("HasViews = true, want false")
+			}
+			if (st.ViewsError != "") != tt.wantViewsErr {
+				t.Errorf("ViewsError = %q, want error: %v", st.ViewsError, tt.wantViewsErr)
+			}
+		})
+	}
+}
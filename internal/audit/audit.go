@@ -0,0 +1,217 @@
+// Package audit keeps an append-only log of every change lnk made on
+// LinkedIn, for compliance review.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/idgen"
+)
+
+// File is the filename for the audit log.
+const File = "audit.jsonl"
+
+// Export formats.
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+)
+
+// Entry is a single logged mutation.
+type Entry struct {
+	ID        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	Account   string    `json:"account,omitempty"`
+	Operation string    `json:"operation"`
+	Target    string    `json:"target,omitempty"`
+	Parent    string    `json:"parent,omitempty"`
+	// ContentHash is the SHA-256 of the text sent, so the log proves what
+	// was posted without storing it.
+	ContentHash string `json:"contentHash,omitempty"`
+}
+
+// HashContent returns the content hash recorded for text, or "" for no text.
+func HashContent(text string) string {
+	if text == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(text))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// ErrNotFound indicates no entry matches the given ID.
+var ErrNotFound = errors.New("audit entry not found")
+
+// Log is the audit log file in a config directory.
+type Log struct {
+	dir string
+}
+
+// NewLog creates a log in the given directory.
+func NewLog(dir string) *Log {
+	return &Log{dir: dir}
+}
+
+// Path returns the log file path.
+func (l *Log) Path() string {
+	return filepath.Join(l.dir, File)
+}
+
+// Append writes e as a new line, assigning its ID and time if unset.
+func (l *Log) Append(e *Entry) error {
+	if e.ID == "" {
+		e.ID = idgen.New()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	if err := os.MkdirAll(l.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	f, err := os.OpenFile(l.Path(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	// A single write keeps concurrent appends from interleaving.
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// List returns all entries, oldest first.
+func (l *Log) List() ([]Entry, error) {
+	f, err := os.Open(l.Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return nil, fmt.Errorf("audit log line %d: %w", n, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// Get returns the entry with the given ID or unique ID prefix.
+func (l *Log) Get(id string) (*Entry, error) {
+	entries, err := l.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var match *Entry
+	for i := range entries {
+		if entries[i].ID == id {
+			return &entries[i], nil
+		}
+		if strings.HasPrefix(entries[i].ID, id) {
+			if match != nil {
+				return nil, fmt.Errorf("audit ID %q is ambiguous", id)
+			}
+			match = &entries[i]
+		}
+	}
+	if match == nil {
+		return nil, ErrNotFound
+	}
+	return match, nil
+}
+
+// Filter selects entries. Zero fields match everything.
+type Filter struct {
+	Since     time.Time
+	Until     time.Time
+	Operation string
+	Target    string
+}
+
+// Match reports whether e passes the filter. Operations compare
+// case-insensitively.
+func (f Filter) Match(e *Entry) bool {
+	switch {
+	case !f.Since.IsZero() && e.Time.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !e.Time.Before(f.Until):
+		return false
+	case f.Operation != "" && !strings.EqualFold(f.Operation, e.Operation):
+		return false
+	case f.Target != "" && f.Target != e.Target && f.Target != e.Parent:
+		return false
+	}
+	return true
+}
+
+// Apply returns the entries that pass the filter.
+func (f Filter) Apply(entries []Entry) []Entry {
+	var out []Entry
+	for i := range entries {
+		if f.Match(&entries[i]) {
+			out = append(out, entries[i])
+		}
+	}
+	return out
+}
+
+// Export writes entries to w in the given format.
+func Export(w io.Writer, entries []Entry, format string) error {
+	switch format {
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		for i := range entries {
+			if err := enc.Encode(&entries[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"id", "time", "account", "operation", "target", "parent", "content_hash"})
+		for _, e := range entries {
+			_ = cw.Write([]string{
+				e.ID, e.Time.UTC().Format(time.RFC3339), e.Account, e.Operation,
+				e.Target, e.Parent, e.ContentHash,
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("invalid format %q: use %s or %s", format, FormatJSONL, FormatCSV)
+	}
+}
@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogAppendList(t *testing.T) {
+	l := NewLog(t.TempDir())
+
+	if entries, err := l.List(); err != nil || len(entries) != 0 {
+		t.Fatalf("List() on empty log = %v, %v", entries, err)
+	}
+
+	first := &Entry{Operation: "CreatePost", Target: "urn:li:share:1", ContentHash: HashContent("Hello")}
+	if err := l.Append(first); err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+	if first.ID == "" || first.Time.IsZero() {
+		t.Errorf("Append() did not assign ID and time: %+v", first)
+	}
+	if err := l.Append(&Entry{Operation: "DeletePost", Target: "urn:li:share:1"}); err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+
+	info, err := os.Stat(l.Path())
+	if err != nil {
+		t.Fatalf("Stat() error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("permissions = %o, want 600", info.Mode().Perm())
+	}
+
+	entries, err := l.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Operation != "CreatePost" || entries[1].Operation != "DeletePost" {
+		t.Fatalf("List() = %+v", entries)
+	}
+
+	got, err := l.Get(first.ID[:4])
+	if err != nil {
+		t.Fatalf("Get(prefix) error: %v", err)
+	}
+	if got.ContentHash != first.ContentHash {
+		t.Errorf("ContentHash = %q, want %q", got.ContentHash, first.ContentHash)
+	}
+	if _, err := l.Get("zzzz"); err != ErrNotFound {
+		t.Errorf("Get(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHashContent(t *testing.T) {
+	if HashContent("") != "" {
+		t.Error("HashContent(\"\") should be empty")
+	}
+	got := HashContent("abc")
+	want := "sha256:ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got != want {
+		t.Errorf("HashContent() = %q, want %q", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{ID: "a", Time: now.Add(-48 * time.Hour), Operation: "CreatePost", Target: "urn:li:share:1"},
+		{ID: "b", Time: now.Add(-time.Hour), Operation: "CreateComment", Target: "urn:li:comment:2", Parent: "urn:li:share:1"},
+		{ID: "c", Time: now, Operation: "SendMessage", Target: "urn:li:fsd_profile:X"},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{name: "all", want: "abc"},
+		{name: "since", filter: Filter{Since: now.Add(-2 * time.Hour)}, want: "bc"},
+		{name: "until", filter: Filter{Until: now}, want: "ab"},
+		{name: "operation", filter: Filter{Operation: "sendmessage"}, want: "c"},
+		{name: "target or parent", filter: Filter{Target: "urn:li:share:1"}, want: "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids string
+			for _, e := range tt.filter.Apply(entries) {
+				ids += e.ID
+			}
+			if ids != tt.want {
+				t.Errorf("Apply() = %q, want %q", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	entries := []Entry{{
+		ID:          "abcd1234",
+		Time:        time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Account:     "urn:li:fsd_profile:ME",
+		Operation:   "CreatePost",
+		Target:      "urn:li:share:1",
+		ContentHash: "sha256:00",
+	}}
+
+	var buf bytes.Buffer
+	if err := Export(&buf, entries, FormatCSV); err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	want := "abcd1234,2026-10-01T12:00:00Z,urn:li:fsd_profile:ME,CreatePost,urn:li:share:1,,sha256:00"
+	if lines[1] != want {
+		t.Errorf("row = %q, want %q", lines[1], want)
+	}
+
+	if err := Export(&buf, entries, "xml"); err == nil {
+		t.Error("Export() with unknown format expected error")
+	}
+}
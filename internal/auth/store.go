@@ -32,6 +32,12 @@ func NewStore() (*Store, error) {
 	return &Store{configDir: configDir}, nil
 }
 
+// ConfigDirPath returns the lnk configuration directory used for credentials
+// and other local state.
+func ConfigDirPath() (string, error) {
+	return getConfigDir()
+}
+
 // getConfigDir returns the configuration directory path.
 func getConfigDir() (string, error) {
 	// Use XDG_CONFIG_HOME if set, otherwise ~/.config.
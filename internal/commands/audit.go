@@ -0,0 +1,281 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/audit"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditSince     string
+	auditUntil     string
+	auditOperation string
+	auditTarget    string
+	auditLimit     int
+	auditFormat    string
+	auditOutput    string
+)
+
+// NewAuditCmd creates the audit command group.
+func NewAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Browse the log of changes lnk made on LinkedIn",
+		Long: `Every successful post, delete, comment, reaction and message is appended to
+~/.config/lnk/audit.jsonl with the time, account, operation, target URN and a
+SHA-256 hash of the content sent.`,
+	}
+
+	cmd.AddCommand(newAuditListCmd())
+	cmd.AddCommand(newAuditShowCmd())
+	cmd.AddCommand(newAuditExportCmd())
+
+	return cmd
+}
+
+// addAuditFilterFlags registers the flags that select audit entries.
+func addAuditFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&auditSince, "since", "", "Only entries at or after this time or age (e.g. 2026-10-01, 24h, 7d)")
+	cmd.Flags().StringVar(&auditUntil, "until", "", "Only entries before this time or age")
+	cmd.Flags().StringVar(&auditOperation, "operation", "", "Only this operation (e.g. CreatePost)")
+	cmd.Flags().StringVar(&auditTarget, "target", "", "Only entries for this target or parent URN")
+}
+
+func newAuditListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recent audit entries",
+		Long: `List audit entries, newest first.
+
+Examples:
+  lnk audit list
+  lnk audit list --since 7d --operation SendMessage`,
+		Args: cobra.NoArgs,
+		RunE: runAuditList,
+	}
+
+	addAuditFilterFlags(cmd)
+	cmd.Flags().IntVarP(&auditLimit, "limit", "n", 50, "Maximum number of entries (0 for all)")
+
+	return cmd
+}
+
+func runAuditList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	entries, err := loadAuditEntries()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	// Newest first.
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	if auditLimit > 0 && len(entries) > auditLimit {
+		entries = entries[:auditLimit]
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]audit.Entry]{
+			Success: true,
+			Data:    entries,
+		})
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No audit entries.")
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s  %s  %-26s %s\n", e.ID, e.Time.Local().Format("2006-01-02 15:04:05"), e.Operation, e.Target)
+	}
+	return nil
+}
+
+func newAuditShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show an audit entry",
+		Long: `Show a single audit entry by ID (or a unique ID prefix).
+
+Example:
+  lnk audit show 3fa2c1d0`,
+		Args: cobra.ExactArgs(1),
+		RunE: runAuditShow,
+	}
+}
+
+func runAuditShow(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	log, err := newAuditLog()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	e, err := log.Get(args[0])
+	if err != nil {
+		if errors.Is(err, audit.ErrNotFound) {
+			return outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+		}
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*audit.Entry]{
+			Success: true,
+			Data:    e,
+		})
+	}
+
+	fmt.Printf("ID: %s\n", e.ID)
+	fmt.Printf("Time: %s\n", e.Time.Local().Format("2006-01-02 15:04:05 MST"))
+	fmt.Printf("Operation: %s\n", e.Operation)
+	if e.Account != "" {
+		fmt.Printf("Account: %s\n", e.Account)
+	}
+	if e.Target != "" {
+		fmt.Printf("Target: %s\n", e.Target)
+	}
+	if e.Parent != "" {
+		fmt.Printf("Parent: %s\n", e.Parent)
+	}
+	if e.ContentHash != "" {
+		fmt.Printf("Content: %s\n", e.ContentHash)
+	}
+	return nil
+}
+
+func newAuditExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export audit entries as JSONL or CSV",
+		Long: `Export audit entries, oldest first, to stdout or a file.
+
+Examples:
+  lnk audit export --format csv --output audit.csv
+  lnk audit export --since 2026-10-01 --until 2026-11-01`,
+		Args: cobra.NoArgs,
+		RunE: runAuditExport,
+	}
+
+	addAuditFilterFlags(cmd)
+	cmd.Flags().StringVar(&auditFormat, "format", audit.FormatJSONL, "Output format: jsonl or csv")
+	cmd.Flags().StringVarP(&auditOutput, "output", "o", "", "Write to file instead of stdout")
+
+	return cmd
+}
+
+func runAuditExport(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if auditFormat != audit.FormatJSONL && auditFormat != audit.FormatCSV {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput,
+			fmt.Sprintf("invalid format %q: use %s or %s", auditFormat, audit.FormatJSONL, audit.FormatCSV))
+	}
+
+	entries, err := loadAuditEntries()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	out := os.Stdout
+	if auditOutput != "" {
+		f, err := os.OpenFile(auditOutput, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := audit.Export(out, entries, auditFormat); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if auditOutput != "" {
+		if jsonOutput {
+			return outputJSON(map[string]any{
+				"success": true,
+				"file":    auditOutput,
+				"count":   len(entries),
+			})
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d entries to %s.\n", len(entries), auditOutput)
+	}
+	return nil
+}
+
+// loadAuditEntries reads the audit log and applies the filter flags.
+func loadAuditEntries() ([]audit.Entry, error) {
+	var filter audit.Filter
+	var err error
+	if filter.Since, err = parseTimeOrAge(auditSince); err != nil {
+		return nil, fmt.Errorf("invalid --since: %w", err)
+	}
+	if filter.Until, err = parseTimeOrAge(auditUntil); err != nil {
+		return nil, fmt.Errorf("invalid --until: %w", err)
+	}
+	filter.Operation = auditOperation
+	if auditTarget != "" {
+		if filter.Target, err = api.ParseURN(auditTarget); err != nil {
+			return nil, err
+		}
+	}
+
+	log, err := newAuditLog()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := log.List()
+	if err != nil {
+		return nil, err
+	}
+	return filter.Apply(entries), nil
+}
+
+// newAuditLog opens the audit log in the config directory.
+func newAuditLog() (*audit.Log, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return audit.NewLog(dir), nil
+}
+
+// newAuditRecorder returns an auditor that appends client mutations to the
+// audit log. The account is the authenticated member's profile URN, looked
+// up once on first use. Failures are reported on stderr but never undo or
+// fail the mutation itself.
+func newAuditRecorder(client *api.Client) func(context.Context, *api.Mutation) {
+	var account string
+	return func(ctx context.Context, m *api.Mutation) {
+		if account == "" {
+			if me, err := client.GetMyProfile(ctx); err == nil {
+				account = me.URN
+			}
+		}
+
+		log, err := newAuditLog()
+		if err == nil {
+			err = log.Append(&audit.Entry{
+				Account:     account,
+				Operation:   m.Operation,
+				Target:      m.Target,
+				Parent:      m.Parent,
+				ContentHash: audit.HashContent(m.Content),
+			})
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to write audit log: %v\n", err)
+		}
+	}
+}
@@ -288,6 +288,11 @@ func outputJSON(v any) error {
 	return enc.Encode(v)
 }
 
+// outputNDJSON writes v as a single line of JSON, for streaming output.
+func outputNDJSON(v any) error {
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
+
 func outputError(jsonOutput bool, code, message string) error {
 	if jsonOutput {
 		_ = outputJSON(api.Response[any]{
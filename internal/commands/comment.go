@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commentsLimit int
+	commentsStart int
+	commentsAll   bool
+)
+
+// maxCommentPages bounds --all so a runaway thread cannot loop forever.
+const maxCommentPages = 50
+
+// NewCommentCmd creates the comment command group.
+func NewCommentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Add, reply to and delete comments",
+		Long: `Commands for writing comments on LinkedIn posts.
+
+Mention people with @[Name](urn:li:fsd_profile:...) in comment text.
+To read comments, use 'lnk post comments <urn>'.`,
+	}
+
+	cmd.AddCommand(newCommentAddCmd())
+	cmd.AddCommand(newCommentReplyCmd())
+	cmd.AddCommand(newCommentDeleteCmd())
+
+	return cmd
+}
+
+func newPostCommentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comments <urn-or-url>",
+		Short: "List comments on a post",
+		Long: `List comments on a post, with replies shown under each comment.
+
+Examples:
+  lnk post comments "urn:li:activity:123456789"
+  lnk post comments "urn:li:activity:123456789" --all --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runPostComments,
+	}
+
+	cmd.Flags().IntVarP(&commentsLimit, "limit", "l", 20, "Comments per page")
+	cmd.Flags().IntVar(&commentsStart, "start", 0, "Offset of the first comment")
+	cmd.Flags().BoolVarP(&commentsAll, "all", "a", false, "Fetch every page")
+
+	return cmd
+}
+
+func runPostComments(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	opts := &api.CommentOptions{Limit: commentsLimit, Start: commentsStart}
+	var all []api.Comment
+	for i := 0; i < maxCommentPages; i++ {
+		page, err := client.GetComments(ctx, urn, opts)
+		if err != nil {
+			return handleAPIError(jsonOutput, err)
+		}
+		all = append(all, page.Comments...)
+
+		// Decide from the raw page, not the threaded result: replies are
+		// nested later, so a full page can thread down to fewer comments.
+		if !commentsAll || len(page.Comments) == 0 {
+			break
+		}
+		opts.Start += opts.Limit
+		if page.Total > 0 && opts.Start >= page.Total {
+			break
+		}
+		if page.Total == 0 && len(page.Comments) < opts.Limit {
+			break
+		}
+	}
+
+	// Thread once every page is in so replies find parents on other pages.
+	comments := api.ThreadComments(all)
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.Comment]{
+			Success: true,
+			Data:    comments,
+		})
+	}
+
+	// Text output.
+	if len(comments) == 0 {
+		fmt.Println("No comments found.")
+		return nil
+	}
+
+	for _, c := range comments {
+		printComment(c, "")
+		for _, r := range c.Replies {
+			printComment(r, "    ")
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// printComment prints a comment with the given indent.
+func printComment(c api.Comment, indent string) {
+	author := c.AuthorName
+	if author == "" {
+		author = "Unknown"
+	}
+	timeStr := ""
+	if !c.CreatedAt.IsZero() {
+		timeStr = fmt.Sprintf(" (%s)", formatTime(c.CreatedAt))
+	}
+
+	fmt.Printf("%s[%s]%s:\n", indent, author, timeStr)
+	for _, line := range strings.Split(c.Text, "\n") {
+		fmt.Printf("%s  %s\n", indent, line)
+	}
+	if c.LikeCount > 0 || c.ReplyCount > 0 {
+		fmt.Printf("%s  Likes: %d, Replies: %d\n", indent, c.LikeCount, c.ReplyCount)
+	}
+	fmt.Printf("%s  URN: %s\n", indent, c.URN)
+}
+
+func newCommentAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <post-urn-or-url> <text>",
+		Short: "Comment on a post",
+		Long: `Add a comment to a post.
+
+Examples:
+  lnk comment add "urn:li:activity:123456789" "Congrats!"
+  lnk comment add "urn:li:activity:123456789" "Nice work @[Jane Doe](urn:li:fsd_profile:ACoAAA)"`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCommentAdd,
+	}
+}
+
+func runCommentAdd(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Comment on "+urn)
+	if err != nil {
+		return err
+	}
+
+	comment, err := client.CreateComment(ctx, urn, args[1])
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	return outputCommentCreated(jsonOutput, comment, "Comment added successfully!")
+}
+
+func newCommentReplyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reply <comment-urn-or-url> <text>",
+		Short: "Reply to a comment",
+		Long: `Reply to an existing comment.
+
+Example:
+  lnk comment reply "urn:li:comment:(activity:123456789,987654321)" "Thanks!"`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCommentReply,
+	}
+}
+
+func runCommentReply(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefComment)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Reply to "+urn)
+	if err != nil {
+		return err
+	}
+
+	comment, err := client.ReplyToComment(ctx, urn, args[1])
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	return outputCommentCreated(jsonOutput, comment, "Reply added successfully!")
+}
+
+// outputCommentCreated reports a newly created comment.
+func outputCommentCreated(jsonOutput bool, comment *api.Comment, message string) error {
+	if jsonOutput {
+		return outputJSON(api.Response[*api.Comment]{
+			Success: true,
+			Data:    comment,
+		})
+	}
+
+	fmt.Println(message)
+	if comment.URN != "" {
+		fmt.Printf("URN: %s\n", comment.URN)
+	}
+	return nil
+}
+
+func newCommentDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <comment-urn-or-url>",
+		Short: "Delete a comment",
+		Long: `Delete one of your comments or replies by URN.
+
+Example:
+  lnk comment delete "urn:li:comment:(activity:123456789,987654321)"`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCommentDelete,
+	}
+}
+
+func runCommentDelete(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefComment)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Delete comment "+urn)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteComment(ctx, urn); err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success": true,
+			"message": "Comment deleted successfully",
+		})
+	}
+
+	fmt.Println("Comment deleted successfully.")
+	return nil
+}
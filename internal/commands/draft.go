@@ -0,0 +1,473 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/draft"
+	"github.com/spf13/cobra"
+)
+
+var (
+	draftFile       string
+	draftTitle      string
+	draftVisibility string
+)
+
+// NewDraftCmd creates the draft command group.
+func NewDraftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft",
+		Short: "Manage local post drafts",
+		Long: `Commands for writing and publishing post drafts.
+
+Drafts are stored as Markdown files with YAML front matter in
+~/.config/lnk/drafts/ and can be edited with any text editor.`,
+	}
+
+	cmd.AddCommand(newDraftNewCmd())
+	cmd.AddCommand(newDraftListCmd())
+	cmd.AddCommand(newDraftShowCmd())
+	cmd.AddCommand(newDraftEditCmd())
+	cmd.AddCommand(newDraftPublishCmd())
+	cmd.AddCommand(newDraftRmCmd())
+
+	return cmd
+}
+
+// addDraftMetaFlags registers the flags that set draft metadata.
+func addDraftMetaFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&draftTitle, "title", "t", "", "Draft title (not published)")
+	cmd.Flags().StringVar(&draftVisibility, "visibility", "", "Post visibility: anyone or connections")
+}
+
+func newDraftNewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new [text]",
+		Short: "Create a new draft",
+		Long: `Create a new draft from text, a file, or your editor.
+
+Without text or --file, $EDITOR is opened on the new draft.
+
+Examples:
+  lnk draft new "Thoughts on Go generics"
+  lnk draft new --file post.md --title "Launch post"
+  lnk draft new --visibility connections`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runDraftNew,
+	}
+
+	cmd.Flags().StringVarP(&draftFile, "file", "f", "", "Read draft content from file")
+	addDraftMetaFlags(cmd)
+
+	return cmd
+}
+
+func runDraftNew(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if err := validateVisibility(draftVisibility); err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	d := &draft.Draft{
+		Title:      draftTitle,
+		Visibility: draftVisibility,
+	}
+
+	useEditor := draftFile == "" && len(args) == 0
+	if !useEditor {
+		d.Body, err = readPostText(draftFile, args)
+		if err != nil {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+		}
+	} else if jsonOutput {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "provide draft text or --file in JSON mode")
+	}
+
+	if err := store.Create(d); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if useEditor {
+		if err := openEditor(store.Path(d.ID)); err != nil {
+			return outputError(jsonOutput, "EDITOR_ERROR", err.Error())
+		}
+		if d, err = store.Get(d.ID); err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		if strings.TrimSpace(d.Body) == "" {
+			_ = store.Delete(d.ID)
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, "draft is empty; discarded")
+		}
+		if err := validateDraft(d); err != nil {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput,
+				fmt.Sprintf("draft %s saved but %v; fix it with: lnk draft edit %s", d.ID, err, d.ID))
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*draft.Draft]{
+			Success: true,
+			Data:    d,
+		})
+	}
+
+	fmt.Printf("Draft %s created.\n", d.ID)
+	fmt.Printf("File: %s\n", store.Path(d.ID))
+	return nil
+}
+
+func newDraftListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List drafts",
+		Long:    `List drafts, most recently updated first.`,
+		RunE:    runDraftList,
+	}
+}
+
+func runDraftList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	drafts, err := store.List()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]draft.Draft]{
+			Success: true,
+			Data:    drafts,
+		})
+	}
+
+	// Text output.
+	if len(drafts) == 0 {
+		fmt.Println("No drafts.")
+		return nil
+	}
+
+	for _, d := range drafts {
+		status := ""
+		if d.IsPublished() {
+			status = " [PUBLISHED]"
+		}
+		summary := d.Summary()
+		if len(summary) > 60 {
+			summary = summary[:57] + "..."
+		}
+		fmt.Printf("%s  %s  %s%s\n", d.ID, d.UpdatedAt.Local().Format("2006-01-02 15:04"), summary, status)
+	}
+
+	return nil
+}
+
+func newDraftShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show a draft",
+		Long:  `Display a draft's metadata and text.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDraftShow,
+	}
+}
+
+func runDraftShow(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	d, err := store.Get(args[0])
+	if err != nil {
+		return draftError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*draft.Draft]{
+			Success: true,
+			Data:    d,
+		})
+	}
+
+	// Text output.
+	fmt.Printf("ID: %s\n", d.ID)
+	if d.Title != "" {
+		fmt.Printf("Title: %s\n", d.Title)
+	}
+	if d.Visibility != "" {
+		fmt.Printf("Visibility: %s\n", d.Visibility)
+	}
+	if len(d.Media) > 0 {
+		fmt.Printf("Media: %s\n", strings.Join(d.Media, ", "))
+	}
+	if d.Account != "" {
+		fmt.Printf("Account: %s\n", d.Account)
+	}
+	fmt.Printf("Updated: %s\n", formatTime(d.UpdatedAt))
+	if d.IsPublished() {
+		fmt.Printf("Published: %s\n", formatTime(d.PublishedAt))
+		fmt.Printf("URN: %s\n", d.URN)
+	}
+	fmt.Printf("\n%s\n", d.Body)
+
+	return nil
+}
+
+func newDraftEditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Edit a draft",
+		Long: `Open a draft in $EDITOR, or update its metadata with flags.
+
+Examples:
+  lnk draft edit 3fa2c1d0
+  lnk draft edit 3fa2c1d0 --visibility connections`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDraftEdit,
+	}
+
+	addDraftMetaFlags(cmd)
+
+	return cmd
+}
+
+func runDraftEdit(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	d, err := store.Get(args[0])
+	if err != nil {
+		return draftError(jsonOutput, err)
+	}
+
+	flags := cmd.Flags()
+	metaChanged := flags.Changed("title") || flags.Changed("visibility")
+
+	if metaChanged {
+		if flags.Changed("title") {
+			d.Title = draftTitle
+		}
+		if flags.Changed("visibility") {
+			if err := validateVisibility(draftVisibility); err != nil {
+				return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+			}
+			d.Visibility = draftVisibility
+		}
+		if err := store.Save(d); err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+	} else {
+		if jsonOutput {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, "interactive editing is not available in JSON mode")
+		}
+		if err := openEditor(store.Path(d.ID)); err != nil {
+			return outputError(jsonOutput, "EDITOR_ERROR", err.Error())
+		}
+		// Re-read and re-save to validate the front matter and bump the timestamp.
+		if d, err = store.Get(d.ID); err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		if err := validateDraft(d); err != nil {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput,
+				fmt.Sprintf("%v; fix it with: lnk draft edit %s", err, d.ID))
+		}
+		if err := store.Save(d); err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*draft.Draft]{
+			Success: true,
+			Data:    d,
+		})
+	}
+
+	fmt.Printf("Draft %s updated.\n", d.ID)
+	return nil
+}
+
+func newDraftPublishCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "publish <id>",
+		Short: "Publish a draft as a post",
+		Long: `Publish a draft and record the resulting post URN in it.
+
+Example:
+  lnk draft publish 3fa2c1d0`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDraftPublish,
+	}
+}
+
+func runDraftPublish(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	d, err := store.Get(args[0])
+	if err != nil {
+		return draftError(jsonOutput, err)
+	}
+
+	switch {
+	case d.IsPublished():
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, fmt.Sprintf("draft already published as %s", d.URN))
+	case strings.TrimSpace(d.Body) == "":
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "draft text cannot be empty")
+	}
+	if err := validateDraft(d); err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, fmt.Sprintf("Publish draft %s", d.ID))
+	if err != nil {
+		return err
+	}
+
+	post, err := client.CreatePostWithOptions(ctx, d.Body, &api.PostOptions{
+		Visibility: d.Visibility,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	d.URN = post.URN
+	d.PublishedAt = time.Now()
+	if err := store.Save(d); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", fmt.Sprintf("post published as %s but draft not updated: %v", post.URN, err))
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*draft.Draft]{
+			Success: true,
+			Data:    d,
+		})
+	}
+
+	fmt.Println("Draft published successfully!")
+	if post.URN != "" {
+		fmt.Printf("URN: %s\n", post.URN)
+	}
+	return nil
+}
+
+func newDraftRmCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rm <id>",
+		Aliases: []string{"delete"},
+		Short:   "Delete a draft",
+		Long:    `Delete a local draft. Published posts are not affected.`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runDraftRm,
+	}
+}
+
+func runDraftRm(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newDraftStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if err := store.Delete(args[0]); err != nil {
+		return draftError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success": true,
+			"message": "Draft deleted successfully",
+		})
+	}
+
+	fmt.Println("Draft deleted.")
+	return nil
+}
+
+// newDraftStore opens the draft store in the config directory.
+func newDraftStore() (*draft.Store, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return draft.NewStore(dir), nil
+}
+
+// draftError converts a draft store error to output.
+func draftError(jsonOutput bool, err error) error {
+	if errors.Is(err, draft.ErrNotFound) {
+		return outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+	}
+	return outputError(jsonOutput, "STORE_ERROR", err.Error())
+}
+
+// validateVisibility checks a --visibility value.
+func validateVisibility(v string) error {
+	switch v {
+	case "", api.VisibilityAnyone, api.VisibilityConnections:
+		return nil
+	default:
+		return fmt.Errorf("invalid visibility %q (use %s or %s)", v, api.VisibilityAnyone, api.VisibilityConnections)
+	}
+}
+
+// validateDraft checks front matter that may have been edited by hand.
+func validateDraft(d *draft.Draft) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	return validateVisibility(d.Visibility)
+}
+
+// openEditor opens path in the user's editor and waits for it to exit.
+func openEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	parts := strings.Fields(editor)
+	c := exec.Command(parts[0], append(parts[1:], path)...) //nolint:gosec // Editor is chosen by the user.
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor failed: %w", err)
+	}
+	return nil
+}
@@ -3,12 +3,32 @@ package commands
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/feedfilter"
 	"github.com/spf13/cobra"
 )
 
-var feedLimit int
+var (
+	feedLimit       int
+	feedFollow      bool
+	feedInterval    time.Duration
+	feedMaxInterval time.Duration
+
+	feedOnly            string
+	feedAuthor          string
+	feedExcludePromoted bool
+	feedMinReactions    int
+	feedMatch           string
+	feedSince           string
+	feedNoRules         bool
+
+	feedHashtag string
+	feedCompany string
+)
 
 // NewFeedCmd creates the feed command.
 func NewFeedCmd() *cobra.Command {
@@ -20,11 +40,56 @@ func NewFeedCmd() *cobra.Command {
 Examples:
   lnk feed
   lnk feed --limit 20
-  lnk feed --json`,
+  lnk feed --json
+  lnk feed --follow
+  lnk feed --follow --json --interval 2m
+  lnk feed --hashtag golang
+  lnk feed --company acme --limit 30
+
+--hashtag and --company read a hashtag or company page feed instead of
+your home timeline; they also apply to --follow and 'lnk feed export'.
+
+With --follow the feed is polled until Ctrl-C and only new items are
+printed (as NDJSON with --json). Polling slows down while nothing new
+arrives and after rate limiting, and speeds up again when items appear.
+
+Filters apply to the fetched items, so fewer than --limit may be shown:
+  lnk feed --only articles --since 24h
+  lnk feed --author "Jane Doe" --min-reactions 20
+  lnk feed --exclude-promoted --match "golang|rust"
+
+Rule files (*.yaml) in ~/.config/lnk/feed-rules/ score or drop items by
+author, keyword, type or promotion, and items are shown highest score
+first:
+
+  rules:
+    - name: hide ads
+      promoted: true
+      action: drop
+    - name: databases
+      keywords: [postgres, sqlite]
+      score: 10
+
+Use 'lnk feed export' to write the feed as Atom, RSS or an HTML digest.`,
 		RunE: runFeed,
 	}
 
 	cmd.Flags().IntVarP(&feedLimit, "limit", "l", 10, "Number of feed items to fetch")
+	cmd.Flags().BoolVarP(&feedFollow, "follow", "f", false, "Keep polling and print new items as they appear")
+	cmd.Flags().DurationVar(&feedInterval, "interval", time.Minute, "Shortest time between polls with --follow")
+	cmd.Flags().DurationVar(&feedMaxInterval, "max-interval", 10*time.Minute, "Longest time between polls with --follow")
+	cmd.Flags().StringVar(&feedOnly, "only", "", "Only show posts, articles or reshares")
+	cmd.Flags().StringVar(&feedAuthor, "author", "", "Only show items from this author (name, username or URN)")
+	cmd.Flags().BoolVar(&feedExcludePromoted, "exclude-promoted", false, "Hide promoted items")
+	cmd.Flags().IntVar(&feedMinReactions, "min-reactions", 0, "Hide items with fewer reactions")
+	cmd.Flags().StringVar(&feedMatch, "match", "", "Only show items whose text matches this regular expression")
+	cmd.Flags().StringVar(&feedSince, "since", "", "Only show items newer than this time or age (e.g. 24h, 7d)")
+	cmd.Flags().BoolVar(&feedNoRules, "no-rules", false, "Ignore rule files in the feed-rules directory")
+	cmd.PersistentFlags().StringVar(&feedHashtag, "hashtag", "", "Read this hashtag's feed instead of your home feed")
+	cmd.PersistentFlags().StringVar(&feedCompany, "company", "", "Read this company page's feed (vanity name, URL or URN)")
+	cmd.MarkFlagsMutuallyExclusive("hashtag", "company")
+
+	cmd.AddCommand(newFeedExportCmd())
 
 	return cmd
 }
@@ -33,15 +98,25 @@ func runFeed(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
+	filter, rules, err := loadFeedFilters()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
 	client, err := getAuthenticatedClient()
 	if err != nil {
 		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
 	}
 
-	items, err := client.GetFeed(ctx, &api.FeedOptions{Limit: feedLimit})
+	if feedFollow {
+		return runFeedFollow(jsonOutput, client, filter, rules)
+	}
+
+	items, err := fetchFeed(ctx, client, feedLimit)
 	if err != nil {
 		return handleAPIError(jsonOutput, err)
 	}
+	items = rules.Apply(filter.Apply(items))
 
 	if jsonOutput {
 		return outputJSON(api.Response[[]api.FeedItem]{
@@ -60,22 +135,121 @@ func runFeed(cmd *cobra.Command, args []string) error {
 		if i > 0 {
 			fmt.Println("---")
 		}
+		printFeedItem(&item)
+	}
+
+	return nil
+}
 
-		if item.Actor != nil && item.Actor.FirstName != "" {
-			fmt.Printf("From: %s\n", item.Actor.FirstName)
+// fetchFeed fetches the feed selected by --hashtag or --company, or the
+// home feed.
+func fetchFeed(ctx context.Context, client *api.Client, limit int) ([]api.FeedItem, error) {
+	opts := &api.FeedOptions{Limit: limit}
+	switch {
+	case feedHashtag != "":
+		return client.GetHashtagFeed(ctx, feedHashtag, opts)
+	case feedCompany != "":
+		return client.GetCompanyFeed(ctx, feedCompany, opts)
+	default:
+		return client.GetFeed(ctx, opts)
+	}
+}
+
+// printFeedItem prints a feed item in text mode.
+func printFeedItem(item *api.FeedItem) {
+	if item.Reason != "" {
+		fmt.Printf("(%s)\n", item.Reason)
+	}
+	if item.Actor != nil && item.Actor.Name != "" {
+		from := item.Actor.Name
+		if item.Actor.Headline != "" {
+			from += " · " + item.Actor.Headline
 		}
+		fmt.Printf("From: %s\n", from)
+	}
+	if !item.CreatedAt.IsZero() {
+		fmt.Printf("Posted: %s\n", formatTime(item.CreatedAt))
+	}
 
-		if item.Post != nil && item.Post.Text != "" {
-			// Truncate long posts in text mode.
-			text := item.Post.Text
-			if len(text) > 200 {
-				text = text[:197] + "..."
+	if item.Post != nil {
+		printFeedPost(item.Post, "")
+		if item.Post.Reshared != nil {
+			name := item.Post.Reshared.AuthorName
+			if name == "" {
+				name = "a post"
 			}
-			fmt.Printf("Post: %s\n", text)
+			fmt.Printf("Reshared from %s:\n", name)
+			printFeedPost(item.Post.Reshared, "  ")
+		}
+		if c := item.Post; c.LikeCount > 0 || c.CommentCount > 0 || c.ShareCount > 0 {
+			fmt.Printf("Reactions: %d, Comments: %d, Reposts: %d\n", c.LikeCount, c.CommentCount, c.ShareCount)
+		}
+	}
+
+	fmt.Printf("URN: %s\n", item.URN)
+}
+
+// printFeedPost prints a post's text and attachments with the given indent.
+func printFeedPost(post *api.Post, indent string) {
+	if post.Text != "" {
+		// Truncate long posts in text mode.
+		text := post.Text
+		if len(text) > 200 {
+			text = text[:197] + "..."
+		}
+		fmt.Printf("%sPost: %s\n", indent, text)
+	}
+	if post.Poll != nil {
+		fmt.Printf("%sPoll: %s\n", indent, post.Poll.Question)
+	}
+	for _, m := range post.Media {
+		label := m.URL
+		if m.Title != "" {
+			label = m.Title + " " + m.URL
 		}
+		fmt.Printf("%s[%s] %s\n", indent, m.Type, label)
+	}
+	if a := post.Article; a != nil {
+		if a.Title != "" {
+			fmt.Printf("%sLink: %s (%s)\n", indent, a.Title, a.URL)
+		} else {
+			fmt.Printf("%sLink: %s\n", indent, a.URL)
+		}
+	}
+}
 
-		fmt.Printf("URN: %s\n", item.URN)
+// loadFeedFilters builds the filter from the command-line flags and loads
+// the rule files unless --no-rules is set.
+func loadFeedFilters() (*feedfilter.Filter, feedfilter.Rules, error) {
+	filter := &feedfilter.Filter{
+		Author:          feedAuthor,
+		ExcludePromoted: feedExcludePromoted,
+		MinReactions:    feedMinReactions,
 	}
 
-	return nil
+	var err error
+	if feedOnly != "" {
+		if filter.Only, err = feedfilter.ParseKind(feedOnly); err != nil {
+			return nil, nil, err
+		}
+	}
+	if filter.Match, err = feedfilter.CompileMatch(feedMatch); err != nil {
+		return nil, nil, fmt.Errorf("invalid --match: %w", err)
+	}
+	if filter.Since, err = parseTimeOrAge(feedSince); err != nil {
+		return nil, nil, fmt.Errorf("invalid --since: %w", err)
+	}
+
+	if feedNoRules {
+		return filter, nil, nil
+	}
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	rules, err := feedfilter.Load(filepath.Join(dir, feedfilter.RulesDir))
+	if err != nil {
+		return nil, nil, err
+	}
+	return filter, rules, nil
 }
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/feedfilter"
+)
+
+const (
+	// feedRequestGap is the minimum time between feed requests in follow
+	// mode, including the fallback endpoints GetFeed tries.
+	feedRequestGap = 2 * time.Second
+
+	// maxSeenItems bounds the set of remembered URNs.
+	maxSeenItems = 5000
+)
+
+// seenSet remembers recently emitted URNs, forgetting the oldest once it
+// holds maxSeenItems.
+type seenSet struct {
+	urns  map[string]bool
+	order []string
+}
+
+func newSeenSet() *seenSet {
+	return &seenSet{urns: map[string]bool{}}
+}
+
+// add records urn and reports whether it was new.
+func (s *seenSet) add(urn string) bool {
+	if s.urns[urn] {
+		return false
+	}
+	s.urns[urn] = true
+	s.order = append(s.order, urn)
+	if len(s.order) > maxSeenItems {
+		delete(s.urns, s.order[0])
+		s.order = s.order[1:]
+	}
+	return true
+}
+
+// runFeedFollow polls the feed until interrupted, printing only new items.
+func runFeedFollow(jsonOutput bool, client *api.Client, filter *feedfilter.Filter, rules feedfilter.Rules) error {
+	if feedInterval <= 0 {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "--interval must be positive")
+	}
+	if feedMaxInterval < feedInterval {
+		feedMaxInterval = feedInterval
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client.SetRateLimit(feedRequestGap)
+
+	if !jsonOutput {
+		fmt.Fprintln(os.Stderr, "Following feed. Press Ctrl-C to stop.")
+	}
+
+	seen := newSeenSet()
+	interval := feedInterval
+	printed := 0
+
+	for {
+		items, err := fetchFeed(ctx, client, feedLimit)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		var apiErr *api.Error
+		switch {
+		case errors.As(err, &apiErr) && apiErr.Code == api.ErrCodeAuthExpired:
+			return handleAPIError(jsonOutput, err)
+		case errors.As(err, &apiErr) && apiErr.Code == api.ErrCodeRateLimited:
+			interval = min(interval*4, feedMaxInterval)
+			fmt.Fprintf(os.Stderr, "Rate limited; next poll in %s.\n", interval)
+		case err != nil:
+			interval = min(interval*2, feedMaxInterval)
+			fmt.Fprintf(os.Stderr, "feed poll failed: %v\n", err)
+		default:
+			// The feed is newest first; emit oldest first like tail.
+			var fresh []api.FeedItem
+			for i := len(items) - 1; i >= 0; i-- {
+				if seen.add(items[i].URN) && filter.Keep(&items[i]) && rules.Keep(&items[i]) {
+					fresh = append(fresh, items[i])
+				}
+			}
+
+			for i := range fresh {
+				if jsonOutput {
+					_ = outputNDJSON(&fresh[i])
+					continue
+				}
+				if printed > 0 {
+					fmt.Println("---")
+				}
+				printFeedItem(&fresh[i])
+				printed++
+			}
+
+			if len(fresh) > 0 {
+				interval = feedInterval
+			} else {
+				interval = min(interval*3/2, feedMaxInterval)
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// NewJobCmd creates the job command group.
+func NewJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "View job postings",
+		Long:  `View LinkedIn job postings. Use 'lnk search jobs' to find them.`,
+	}
+
+	cmd.AddCommand(newJobGetCmd())
+
+	return cmd
+}
+
+func newJobGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id|url>",
+		Short: "Show a job posting",
+		Long: `Show a job posting's full description, applicant count, workplace type
+and apply URL.
+
+Examples:
+  lnk job get 3812345678
+  lnk job get https://www.linkedin.com/jobs/view/3812345678/`,
+		Args: cobra.ExactArgs(1),
+		RunE: runJobGet,
+	}
+}
+
+func runJobGet(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	if _, err := api.ParseJobID(args[0]); err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	job, err := client.GetJob(ctx, args[0])
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*api.Job]{
+			Success: true,
+			Data:    job,
+		})
+	}
+
+	fmt.Printf("Title: %s\n", job.Title)
+	if job.CompanyName != "" {
+		fmt.Printf("Company: %s\n", job.CompanyName)
+	}
+	if job.Location != "" {
+		fmt.Printf("Location: %s\n", job.Location)
+	}
+	if job.WorkplaceType != "" {
+		fmt.Printf("Workplace: %s\n", job.WorkplaceType)
+	}
+	if !job.PostedAt.IsZero() {
+		fmt.Printf("Posted: %s\n", formatTime(job.PostedAt))
+	}
+	if job.ApplicantCount > 0 {
+		fmt.Printf("Applicants: %d\n", job.ApplicantCount)
+	}
+	switch {
+	case job.EasyApply:
+		fmt.Printf("Apply: Easy Apply on %s\n", job.URL)
+	case job.ApplyURL != "":
+		fmt.Printf("Apply: %s\n", job.ApplyURL)
+	}
+	fmt.Printf("URL: %s\n", job.URL)
+	if job.Description != "" {
+		fmt.Printf("\n%s\n", job.Description)
+	}
+	return nil
+}
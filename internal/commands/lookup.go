@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/typeahead"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lookupLimit      int
+	lookupRefresh    bool
+	lookupClearCache bool
+)
+
+// NewLookupCmd creates the lookup command.
+func NewLookupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lookup <kind> <text>",
+		Short: "Look up people, companies, places and more by name",
+		Long: `Look up LinkedIn entities by name through typeahead, as the search box
+does, and print their URNs.
+
+Kinds: people, companies, geo, schools, skills, titles, industries.
+
+Results are cached in ~/.config/lnk/typeahead-cache.json for 30 days and
+are shared with search filters such as --location and --current-company.
+Lookups that find nothing are not cached.
+
+Examples:
+  lnk lookup geo Berlin
+  lnk lookup companies "Acme Corp" --limit 3
+  lnk lookup people "Jane Doe" --json
+  lnk lookup skills golang --refresh
+  lnk lookup --clear-cache`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if lookupClearCache {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		RunE: runLookup,
+	}
+
+	cmd.Flags().IntVarP(&lookupLimit, "limit", "l", 10, "Maximum number of results")
+	cmd.Flags().BoolVar(&lookupRefresh, "refresh", false, "Ignore cached results and look up again")
+	cmd.Flags().BoolVar(&lookupClearCache, "clear-cache", false, "Remove all cached lookups")
+
+	return cmd
+}
+
+func runLookup(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	if lookupClearCache {
+		cache, err := newTypeaheadCache()
+		if err == nil {
+			err = cache.Clear()
+		}
+		if err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		if jsonOutput {
+			return outputJSON(map[string]any{"success": true})
+		}
+		fmt.Println("Typeahead cache cleared.")
+		return nil
+	}
+
+	kind, err := api.ParseTypeaheadKind(args[0])
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+	if lookupRefresh {
+		cache, err := newTypeaheadCache()
+		if err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		client.SetTypeaheadCache(&typeaheadCacheAdapter{cache: cache, refresh: true})
+	}
+
+	hits, err := client.Typeahead(ctx, kind, args[1])
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+	if lookupLimit > 0 && len(hits) > lookupLimit {
+		hits = hits[:lookupLimit]
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.TypeaheadHit]{
+			Success: true,
+			Data:    hits,
+		})
+	}
+
+	// Text output.
+	if len(hits) == 0 {
+		fmt.Println("No results found.")
+		return nil
+	}
+
+	for i, h := range hits {
+		fmt.Printf("%d. %s\n", i+1, h.Text)
+		if h.Subtext != "" {
+			fmt.Printf("   %s\n", h.Subtext)
+		}
+		fmt.Printf("   URN: %s\n", h.URN)
+	}
+
+	return nil
+}
+
+// newTypeaheadCache returns the typeahead cache in the config directory.
+func newTypeaheadCache() (*typeahead.Cache, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return typeahead.NewCache(dir, typeahead.DefaultTTL), nil
+}
+
+// typeaheadCacheAdapter lets the client use the typeahead cache. Cache
+// failures are reported on stderr and treated as misses, so a broken cache
+// never stops a lookup. With refresh set, cached hits are ignored but new
+// ones are still stored.
+type typeaheadCacheAdapter struct {
+	cache   *typeahead.Cache
+	refresh bool
+}
+
+func (a *typeaheadCacheAdapter) Get(kind, text string) ([]api.TypeaheadHit, bool) {
+	if a.refresh {
+		return nil, false
+	}
+	hits, ok, err := a.cache.Get(kind, text, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return nil, false
+	}
+	return hits, ok
+}
+
+func (a *typeaheadCacheAdapter) Put(kind, text string, hits []api.TypeaheadHit) {
+	if err := a.cache.Put(kind, text, hits, time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
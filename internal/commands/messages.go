@@ -113,7 +113,7 @@ func runMessagesList(cmd *cobra.Command, args []string) error {
 
 func newMessagesGetCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "get <conversation-urn>",
+		Use:   "get <conversation-urn-or-url>",
 		Short: "Get messages in a conversation",
 		Long: `View messages in a specific conversation.
 
@@ -128,7 +128,10 @@ func runMessagesGet(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
-	conversationURN := args[0]
+	conversationURN, err := api.ParseURN(args[0], api.RefConversation)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
 
 	client, err := getAuthenticatedClient()
 	if err != nil {
@@ -183,7 +186,7 @@ func runMessagesGet(cmd *cobra.Command, args []string) error {
 
 func newMessagesSendCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "send <profile-urn-or-username> <message>",
+		Use:   "send <profile-urn-username-or-url> <message>",
 		Short: "Send a message to a profile",
 		Long: `Send a new message to a LinkedIn profile.
 
@@ -202,22 +205,15 @@ func runMessagesSend(cmd *cobra.Command, args []string) error {
 	target := args[0]
 	text := args[1]
 
-	client, err := getAuthenticatedClient()
+	client, err := mutationClient(cmd, jsonOutput, fmt.Sprintf("Send %s to %s", quoteText(text), target))
 	if err != nil {
-		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+		return err
 	}
 
-	// If target doesn't look like a URN, treat it as a username and look up the profile.
-	profileURN := target
-	if !strings.HasPrefix(target, "urn:") {
-		profile, err := client.GetProfile(ctx, target)
-		if err != nil {
-			return handleAPIError(jsonOutput, err)
-		}
-		if profile.URN == "" {
-			return outputError(jsonOutput, api.ErrCodeNotFound, "could not find profile URN for "+target)
-		}
-		profileURN = profile.URN
+	// Resolve usernames and profile URLs to a URN.
+	profileURN, err := client.ResolveProfileURN(ctx, target)
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
 	}
 
 	msg, err := client.SendMessage(ctx, profileURN, text)
@@ -238,7 +234,7 @@ func runMessagesSend(cmd *cobra.Command, args []string) error {
 
 func newMessagesReplyCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "reply <conversation-urn> <message>",
+		Use:   "reply <conversation-urn-or-url> <message>",
 		Short: "Reply to a conversation",
 		Long: `Reply to an existing conversation.
 
@@ -253,12 +249,15 @@ func runMessagesReply(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
-	conversationURN := args[0]
+	conversationURN, err := api.ParseURN(args[0], api.RefConversation)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
 	text := args[1]
 
-	client, err := getAuthenticatedClient()
+	client, err := mutationClient(cmd, jsonOutput, fmt.Sprintf("Send %s to %s", quoteText(text), conversationURN))
 	if err != nil {
-		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+		return err
 	}
 
 	msg, err := client.SendMessageToConversation(ctx, conversationURN, text)
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pollOptions    []string
+	pollDuration   string
+	pollText       string
+	pollVisibility string
+)
+
+// pollDurations maps --duration values to LinkedIn poll durations.
+var pollDurations = map[string]string{
+	"1d": api.PollDurationOneDay,
+	"3d": api.PollDurationThreeDays,
+	"1w": api.PollDurationOneWeek,
+	"2w": api.PollDurationTwoWeeks,
+}
+
+func newPostPollCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poll <question>",
+		Short: "Create a post with a poll",
+		Long: `Create a LinkedIn post with a poll of 2 to 4 options.
+
+Durations: 1d, 3d, 1w (default) or 2w.
+
+Examples:
+  lnk post poll "Tabs or spaces?" --option Tabs --option Spaces
+  lnk post poll "Best day to ship?" -o Mon -o Wed -o Fri --duration 3d --text "Settle this for us"`,
+		Args: cobra.ExactArgs(1),
+		RunE: runPostPoll,
+	}
+
+	cmd.Flags().StringArrayVarP(&pollOptions, "option", "o", nil, "Poll option (repeat 2-4 times)")
+	cmd.Flags().StringVarP(&pollDuration, "duration", "d", "1w", "How long the poll runs: 1d, 3d, 1w or 2w")
+	cmd.Flags().StringVarP(&pollText, "text", "t", "", "Post text shown above the poll")
+	cmd.Flags().StringVar(&pollVisibility, "visibility", "", "Post visibility: anyone or connections")
+
+	return cmd
+}
+
+func runPostPoll(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	duration, ok := pollDurations[strings.ToLower(pollDuration)]
+	if !ok {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput,
+			fmt.Sprintf("invalid duration %q: use 1d, 3d, 1w or 2w", pollDuration))
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Publish poll "+quoteText(args[0]))
+	if err != nil {
+		return err
+	}
+
+	post, err := client.CreatePoll(ctx, pollText, &api.PollOptions{
+		PostOptions: api.PostOptions{Visibility: pollVisibility},
+		Question:    args[0],
+		Options:     pollOptions,
+		Duration:    duration,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*api.Post]{
+			Success: true,
+			Data:    post,
+		})
+	}
+
+	fmt.Println("Poll created successfully!")
+	if post.URN != "" {
+		fmt.Printf("URN: %s\n", post.URN)
+	}
+	if post.Poll != nil && post.Poll.URN != "" {
+		fmt.Printf("Poll URN: %s\n", post.Poll.URN)
+	}
+
+	return nil
+}
+
+// printPoll prints a poll with per-option vote counts.
+func printPoll(poll *api.Poll) {
+	fmt.Printf("Poll: %s\n", poll.Question)
+	for _, o := range poll.Options {
+		pct := 0
+		if poll.TotalVotes > 0 {
+			pct = o.VoteCount * 100 / poll.TotalVotes
+		}
+		fmt.Printf("  %-30s %5d  (%d%%)\n", o.Text, o.VoteCount, pct)
+	}
+	fmt.Printf("Votes: %d\n", poll.TotalVotes)
+	if !poll.EndsAt.IsZero() {
+		fmt.Printf("Ends: %s\n", poll.EndsAt.Local().Format("2006-01-02 15:04 MST"))
+	}
+}
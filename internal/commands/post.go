@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/markdown"
 	"github.com/spf13/cobra"
 )
 
-var postFile string
+var (
+	postFile       string
+	postFormat     string
+	postPreview    bool
+	postUndoWindow time.Duration
+)
 
 // NewPostCmd creates the post command group.
 func NewPostCmd() *cobra.Command {
@@ -21,8 +28,15 @@ func NewPostCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newPostCreateCmd())
+	cmd.AddCommand(newPostListCmd())
 	cmd.AddCommand(newPostGetCmd())
 	cmd.AddCommand(newPostDeleteCmd())
+	cmd.AddCommand(newPostScheduleCmd())
+	cmd.AddCommand(newPostPollCmd())
+	cmd.AddCommand(newPostReshareCmd())
+	cmd.AddCommand(newPostCommentsCmd())
+	cmd.AddCommand(newPostReactionsCmd())
+	cmd.AddCommand(newPostStatsCmd())
 
 	return cmd
 }
@@ -35,12 +49,20 @@ func newPostCreateCmd() *cobra.Command {
 
 Examples:
   lnk post create "Hello LinkedIn!"
-  lnk post create --file post.txt`,
+  lnk post create --file post.txt
+  lnk post create --file post.md --format markdown --preview
+  lnk post create --undo-window 30s "Hello LinkedIn!"
+
+With --undo-window the command waits before returning; pressing Ctrl-C or
+running 'lnk undo' in that time deletes the post again.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: runPostCreate,
 	}
 
 	cmd.Flags().StringVarP(&postFile, "file", "f", "", "Read post content from file")
+	cmd.Flags().StringVar(&postFormat, "format", "plain", "Input format: plain or markdown")
+	cmd.Flags().BoolVar(&postPreview, "preview", false, "Print the converted post without publishing")
+	cmd.Flags().DurationVar(&postUndoWindow, "undo-window", 0, "Wait this long after posting so the post can be undone (e.g. 30s)")
 
 	return cmd
 }
@@ -49,27 +71,42 @@ func runPostCreate(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
-	// Get post text.
-	var text string
-	if postFile != "" {
-		content, err := os.ReadFile(postFile)
-		if err != nil {
-			return outputError(jsonOutput, api.ErrCodeInvalidInput, fmt.Sprintf("failed to read file: %v", err))
+	if postUndoWindow < 0 {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "--undo-window cannot be negative")
+	}
+
+	text, err := readPostText(postFile, args)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	text, err = formatPostText(text, postFormat)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+	warnings := markdown.Warnings(text)
+
+	if postPreview {
+		if jsonOutput {
+			return outputJSON(map[string]any{
+				"success":  true,
+				"text":     text,
+				"length":   markdown.Length(text),
+				"warnings": warnings,
+			})
 		}
-		text = strings.TrimSpace(string(content))
-	} else if len(args) > 0 {
-		text = args[0]
-	} else {
-		return outputError(jsonOutput, api.ErrCodeInvalidInput, "provide post text or --file")
+		fmt.Println(text)
+		printWarnings(warnings)
+		return nil
 	}
 
-	if text == "" {
-		return outputError(jsonOutput, api.ErrCodeInvalidInput, "post text cannot be empty")
+	if !jsonOutput {
+		printWarnings(warnings)
 	}
 
-	client, err := getAuthenticatedClient()
+	client, err := mutationClient(cmd, jsonOutput, "Publish post "+quoteText(text))
 	if err != nil {
-		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+		return err
 	}
 
 	post, err := client.CreatePost(ctx, text)
@@ -77,6 +114,10 @@ func runPostCreate(cmd *cobra.Command, args []string) error {
 		return handleAPIError(jsonOutput, err)
 	}
 
+	if postUndoWindow > 0 && post.URN != "" {
+		return runUndoWindow(ctx, jsonOutput, client, post, postUndoWindow)
+	}
+
 	if jsonOutput {
 		return outputJSON(api.Response[*api.Post]{
 			Success: true,
@@ -92,14 +133,60 @@ func runPostCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// readPostText returns post text from a file or the first positional argument.
+func readPostText(file string, args []string) (string, error) {
+	var text string
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		text = strings.TrimSpace(string(content))
+	} else if len(args) > 0 {
+		text = args[0]
+	} else {
+		return "", fmt.Errorf("provide post text or --file")
+	}
+
+	if text == "" {
+		return "", fmt.Errorf("post text cannot be empty")
+	}
+	return text, nil
+}
+
+// formatPostText converts post text from the given input format into the
+// plain text LinkedIn renders.
+func formatPostText(text, format string) (string, error) {
+	switch format {
+	case "", "plain":
+		return text, nil
+	case "markdown", "md":
+		text = markdown.Convert(text)
+		if text == "" {
+			return "", fmt.Errorf("post text cannot be empty")
+		}
+		return text, nil
+	default:
+		return "", fmt.Errorf("invalid format %q: use plain or markdown", format)
+	}
+}
+
+// printWarnings writes post warnings to stderr.
+func printWarnings(warnings []string) {
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+}
+
 func newPostGetCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "get <urn>",
+		Use:   "get <urn-or-url>",
 		Short: "Get a post by URN",
 		Long: `Fetch and display a LinkedIn post by its URN.
 
-Example:
-  lnk post get "urn:li:activity:123456789"`,
+Examples:
+  lnk post get "urn:li:activity:123456789"
+  lnk post get https://www.linkedin.com/posts/jane_launch-activity-123456789-AbCd`,
 		Args: cobra.ExactArgs(1),
 		RunE: runPostGet,
 	}
@@ -109,7 +196,10 @@ func runPostGet(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
-	urn := args[0]
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
 
 	client, err := getAuthenticatedClient()
 	if err != nil {
@@ -135,6 +225,9 @@ func runPostGet(cmd *cobra.Command, args []string) error {
 	if post.Text != "" {
 		fmt.Printf("Text: %s\n", post.Text)
 	}
+	if post.Poll != nil {
+		printPoll(post.Poll)
+	}
 	if post.URN != "" {
 		fmt.Printf("URN: %s\n", post.URN)
 	}
@@ -147,7 +240,7 @@ func runPostGet(cmd *cobra.Command, args []string) error {
 
 func newPostDeleteCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "delete <urn>",
+		Use:   "delete <urn-or-url>",
 		Short: "Delete a post by URN",
 		Long: `Delete a LinkedIn post by its URN.
 
@@ -162,11 +255,14 @@ func runPostDelete(cmd *cobra.Command, args []string) error {
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	ctx := context.Background()
 
-	urn := args[0]
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
 
-	client, err := getAuthenticatedClient()
+	client, err := mutationClient(cmd, jsonOutput, "Delete post "+urn)
 	if err != nil {
-		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+		return err
 	}
 
 	if err := client.DeletePost(ctx, urn); err != nil {
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	postListLimit int
+	postListStart int
+)
+
+func newPostListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [username-or-url]",
+		Short: "List your or a member's recent posts",
+		Long: `List recent posts by you, or by another member when a username is given.
+
+Examples:
+  lnk post list
+  lnk post list johndoe --limit 50 --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runPostList,
+	}
+
+	cmd.Flags().IntVarP(&postListLimit, "limit", "l", 10, "Maximum number of posts")
+	cmd.Flags().IntVar(&postListStart, "start", 0, "Offset of the first post")
+
+	return cmd
+}
+
+func runPostList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	var profileURN string
+	if len(args) > 0 {
+		profileURN, err = client.ResolveProfileURN(ctx, args[0])
+	} else {
+		var me *api.Profile
+		if me, err = client.GetMyProfile(ctx); err == nil {
+			profileURN = me.URN
+		}
+	}
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+	if profileURN == "" {
+		return outputError(jsonOutput, api.ErrCodeNotFound, "could not determine profile URN")
+	}
+
+	posts, err := client.GetMemberPosts(ctx, profileURN, &api.MemberPostsOptions{
+		Limit: postListLimit,
+		Start: postListStart,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.Post]{
+			Success: true,
+			Data:    posts,
+		})
+	}
+
+	// Text output.
+	if len(posts) == 0 {
+		fmt.Println("No posts found.")
+		return nil
+	}
+
+	for i, post := range posts {
+		if i > 0 {
+			fmt.Println("---")
+		}
+		if !post.CreatedAt.IsZero() {
+			fmt.Printf("Posted: %s\n", formatTime(post.CreatedAt))
+		}
+		if post.Text != "" {
+			// Truncate long posts in text mode.
+			text := strings.ReplaceAll(post.Text, "\n", " ")
+			if len(text) > 200 {
+				text = text[:197] + "..."
+			}
+			fmt.Printf("Post: %s\n", text)
+		}
+		fmt.Printf("Reactions: %d, Comments: %d, Reposts: %d\n", post.LikeCount, post.CommentCount, post.ShareCount)
+		fmt.Printf("URN: %s\n", post.URN)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/pp/lnk/internal/api"
 	"github.com/pp/lnk/internal/auth"
@@ -53,18 +55,19 @@ func runProfileMe(cmd *cobra.Command, args []string) error {
 
 func newProfileGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get [username]",
+		Use:   "get [username-or-url]",
 		Short: "View a profile by username",
 		Long: `Fetch and display a LinkedIn profile by username (public identifier).
 
 Examples:
   lnk profile get johndoe
+  lnk profile get https://www.linkedin.com/in/johndoe/
   lnk profile get --urn "urn:li:member:123456"`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: runProfileGet,
 	}
 
-	cmd.Flags().StringVar(&profileURN, "urn", "", "Profile URN (alternative to username)")
+	cmd.Flags().StringVar(&profileURN, "urn", "", "Profile URN or URL (alternative to username)")
 
 	return cmd
 }
@@ -83,12 +86,28 @@ func runProfileGet(cmd *cobra.Command, args []string) error {
 		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
 	}
 
-	var profile *api.Profile
+	target := profileURN
+	if target == "" {
+		target = args[0]
+	}
 
-	if profileURN != "" {
-		profile, err = client.GetProfileByURN(ctx, profileURN)
+	var profile *api.Profile
+	if strings.ContainsAny(target, ":/") {
+		// A URN or profile URL.
+		var ref *api.Ref
+		ref, err = api.ParseRef(target)
+		switch {
+		case err != nil:
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+		case ref.Kind != api.RefProfile:
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, fmt.Sprintf("not a profile: %s", target))
+		case ref.URN != "":
+			profile, err = client.GetProfileByURN(ctx, ref.URN)
+		default:
+			profile, err = client.GetProfile(ctx, ref.PublicID)
+		}
 	} else {
-		profile, err = client.GetProfile(ctx, args[0])
+		profile, err = client.GetProfile(ctx, target)
 	}
 
 	if err != nil {
@@ -118,11 +137,19 @@ func getAuthenticatedClient() (*api.Client, error) {
 	}
 
 	client := api.NewClient(api.WithCredentials(creds))
+	client.SetAuditor(newAuditRecorder(client))
+	if cache, err := newTypeaheadCache(); err == nil {
+		client.SetTypeaheadCache(&typeaheadCacheAdapter{cache: cache})
+	}
 	return client, nil
 }
 
 // handleAPIError converts an API error to output.
+// A dry run is not an error: the request has already been printed.
 func handleAPIError(jsonOutput bool, err error) error {
+	if errors.Is(err, api.ErrDryRun) {
+		return nil
+	}
 	if apiErr, ok := err.(*api.Error); ok {
 		return outputError(jsonOutput, apiErr.Code, apiErr.Message)
 	}
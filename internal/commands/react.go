@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reactionsLimit int
+	reactionsStart int
+	reactionsAll   bool
+	reactionsType  string
+)
+
+// maxReactionPages bounds --all so a viral post cannot loop forever.
+const maxReactionPages = 50
+
+// NewReactCmd creates the react command.
+func NewReactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "react <urn-or-url> <like|celebrate|support|love|insightful|funny>",
+		Short: "React to a post or comment",
+		Long: `React to a post or comment. Reacting again replaces your previous reaction.
+
+Examples:
+  lnk react "urn:li:activity:123456789" like
+  lnk react "urn:li:comment:(activity:123456789,987654321)" insightful`,
+		Args: cobra.ExactArgs(2),
+		RunE: runReact,
+	}
+}
+
+func runReact(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost, api.RefComment)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	reactionType, err := api.ParseReactionType(args[1])
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, fmt.Sprintf("React with %s to %s", api.ReactionName(reactionType), urn))
+	if err != nil {
+		return err
+	}
+
+	if err := client.React(ctx, urn, reactionType); err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success":  true,
+			"urn":      urn,
+			"reaction": reactionType,
+		})
+	}
+
+	fmt.Printf("Reacted with %s.\n", api.ReactionName(reactionType))
+	return nil
+}
+
+// NewUnreactCmd creates the unreact command.
+func NewUnreactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unreact <urn-or-url>",
+		Short: "Remove your reaction from a post or comment",
+		Long: `Remove your reaction from a post or comment.
+
+Example:
+  lnk unreact "urn:li:activity:123456789"`,
+		Args: cobra.ExactArgs(1),
+		RunE: runUnreact,
+	}
+}
+
+func runUnreact(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost, api.RefComment)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Remove your reaction from "+urn)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Unreact(ctx, urn); err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success": true,
+			"message": "Reaction removed",
+		})
+	}
+
+	fmt.Println("Reaction removed.")
+	return nil
+}
+
+func newPostReactionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reactions <urn-or-url>",
+		Short: "List who reacted to a post",
+		Long: `List the members who reacted to a post or comment.
+
+Examples:
+  lnk post reactions "urn:li:activity:123456789"
+  lnk post reactions "urn:li:activity:123456789" --type celebrate --all`,
+		Args: cobra.ExactArgs(1),
+		RunE: runPostReactions,
+	}
+
+	cmd.Flags().IntVarP(&reactionsLimit, "limit", "l", 20, "Reactions per page")
+	cmd.Flags().IntVar(&reactionsStart, "start", 0, "Offset of the first reaction")
+	cmd.Flags().BoolVarP(&reactionsAll, "all", "a", false, "Fetch every page")
+	cmd.Flags().StringVarP(&reactionsType, "type", "t", "", "Only show one reaction type")
+
+	return cmd
+}
+
+func runPostReactions(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost, api.RefComment)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	opts := &api.ReactionOptions{Limit: reactionsLimit, Start: reactionsStart}
+	if reactionsType != "" {
+		if opts.Type, err = api.ParseReactionType(reactionsType); err != nil {
+			return handleAPIError(jsonOutput, err)
+		}
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	reactions := []api.Reaction{}
+	for page := 0; page < maxReactionPages; page++ {
+		page, err := client.GetReactions(ctx, urn, opts)
+		if err != nil {
+			return handleAPIError(jsonOutput, err)
+		}
+		reactions = append(reactions, page.Reactions...)
+		if !reactionsAll || !page.More(opts) {
+			break
+		}
+		opts.Start += opts.Limit
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.Reaction]{
+			Success: true,
+			Data:    reactions,
+		})
+	}
+
+	// Text output.
+	if len(reactions) == 0 {
+		fmt.Println("No reactions found.")
+		return nil
+	}
+
+	for i, r := range reactions {
+		name := r.Reactor.Name
+		if name == "" {
+			name = strings.TrimSpace(r.Reactor.FirstName + " " + r.Reactor.LastName)
+		}
+		if name == "" {
+			name = "(Unknown)"
+		}
+		fmt.Printf("%d. %s [%s]\n", i+1, name, api.ReactionName(r.Type))
+		if r.Reactor.Headline != "" {
+			fmt.Printf("   %s\n", r.Reactor.Headline)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reshareFormat     string
+	reshareVisibility string
+)
+
+func newPostReshareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reshare <urn-or-url> [text]",
+		Aliases: []string{"repost"},
+		Short:   "Repost a post, optionally with commentary",
+		Long: `Repost an existing LinkedIn post. Without text this is a plain repost;
+with text your commentary is shown above the original post.
+
+Examples:
+  lnk post reshare "urn:li:activity:123456789"
+  lnk post reshare https://www.linkedin.com/feed/update/urn:li:activity:123456789/ "Worth a read"`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: runPostReshare,
+	}
+
+	cmd.Flags().StringVar(&reshareFormat, "format", "plain", "Commentary format: plain or markdown")
+	cmd.Flags().StringVar(&reshareVisibility, "visibility", "", "Post visibility: anyone or connections")
+
+	return cmd
+}
+
+func runPostReshare(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	var commentary string
+	if len(args) > 1 && strings.TrimSpace(args[1]) != "" {
+		commentary, err = formatPostText(args[1], reshareFormat)
+		if err != nil {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+		}
+	}
+
+	client, err := mutationClient(cmd, jsonOutput, "Reshare "+urn)
+	if err != nil {
+		return err
+	}
+
+	post, err := client.ReshareWithOptions(ctx, urn, commentary, &api.PostOptions{
+		Visibility: reshareVisibility,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*api.Post]{
+			Success: true,
+			Data:    post,
+		})
+	}
+
+	fmt.Println("Post reshared successfully!")
+	if post.URN != "" {
+		fmt.Printf("URN: %s\n", post.URN)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// ErrCodeAborted is reported when the user declines a confirmation prompt.
+const ErrCodeAborted = "ABORTED"
+
+// mutationClient returns an authenticated client for a command that changes
+// state on LinkedIn. With --dry-run the client prints each request instead of
+// sending it; otherwise the user must confirm the action (or pass --yes).
+// Errors have already been reported through outputError.
+func mutationClient(cmd *cobra.Command, jsonOutput bool, action string) (*api.Client, error) {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return nil, outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	if dryRun {
+		client.SetDryRun(func(r *api.DryRunRequest) {
+			printDryRun(jsonOutput, r)
+		})
+		return client, nil
+	}
+
+	if !yes {
+		if err := confirm(jsonOutput, action); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// confirm asks the user to approve action on the terminal.
+func confirm(jsonOutput bool, action string) error {
+	if jsonOutput {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput,
+			fmt.Sprintf("%s: pass --yes to confirm in JSON mode", action))
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput,
+			fmt.Sprintf("%s: pass --yes to confirm when stdin is not a terminal", action))
+	}
+
+	fmt.Fprintf(os.Stderr, "%s? [y/N] ", action)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return outputError(jsonOutput, ErrCodeAborted, "aborted")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+	return outputError(jsonOutput, ErrCodeAborted, "aborted")
+}
+
+// printDryRun prints a request that was not sent.
+func printDryRun(jsonOutput bool, r *api.DryRunRequest) {
+	if jsonOutput {
+		_ = outputJSON(map[string]any{
+			"success": true,
+			"dryRun":  true,
+			"request": r,
+		})
+		return
+	}
+
+	fmt.Println("Dry run: request not sent.")
+	fmt.Printf("%s %s\n", r.Method, r.URL)
+	if len(r.Body) > 0 {
+		var body any
+		if err := json.Unmarshal(r.Body, &body); err == nil {
+			if pretty, err := json.MarshalIndent(body, "", "  "); err == nil {
+				fmt.Println(string(pretty))
+				return
+			}
+		}
+		fmt.Println(string(r.Body))
+	}
+}
+
+// quoteText shortens text for use in a confirmation prompt.
+func quoteText(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if r := []rune(text); len(r) > 60 {
+		text = string(r[:57]) + "..."
+	}
+	return fmt.Sprintf("%q", text)
+}
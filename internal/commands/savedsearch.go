@@ -0,0 +1,481 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/savedsearch"
+	"github.com/spf13/cobra"
+)
+
+var (
+	savedSearchForce bool
+	savedSearchLimit int
+	savedSearchSort  string
+)
+
+// savedSearchFlags lists the filter flags that apply to each search type.
+var savedSearchFlags = map[string][]string{
+	savedsearch.TypePeople:    {"network", "location", "current-company", "past-company", "industry", "school", "title"},
+	savedsearch.TypeCompanies: {},
+	savedsearch.TypeJobs:      {"location", "remote", "experience", "posted-within", "company"},
+	savedsearch.TypePosts:     {"sort", "date-posted", "from-member"},
+}
+
+func newSearchSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save <name> <people|companies|jobs|posts> <query>",
+		Short: "Save a search to run again later",
+		Long: `Save a search under a name. The filter flags are those of the matching
+'lnk search <type>' command.
+
+Examples:
+  lnk search save ios people "ios engineer" --location Berlin --network 2nd
+  lnk search save go-jobs jobs "go engineer" --remote --posted-within 7d
+  lnk search save mentions posts "lnk cli" --sort recent`,
+		Args: cobra.ExactArgs(3),
+		RunE: runSearchSave,
+	}
+
+	cmd.Flags().IntVarP(&savedSearchLimit, "limit", "l", 25, "Maximum number of results per run")
+	cmd.Flags().BoolVar(&savedSearchForce, "force", false, "Replace an existing search with the same name and drop its history")
+
+	// People filters.
+	cmd.Flags().StringSliceVar(&searchNetwork, "network", nil, "Connection degrees: 1st, 2nd, 3rd (comma-separated)")
+	cmd.Flags().StringArrayVar(&searchLocations, "location", nil, "Location name or geo URN")
+	cmd.Flags().StringArrayVar(&searchCurrentCompany, "current-company", nil, "Current company name, URL or URN")
+	cmd.Flags().StringArrayVar(&searchPastCompany, "past-company", nil, "Past company name, URL or URN")
+	cmd.Flags().StringArrayVar(&searchIndustries, "industry", nil, "Industry name or ID")
+	cmd.Flags().StringArrayVar(&searchSchools, "school", nil, "School name or URN")
+	cmd.Flags().StringVar(&searchTitle, "title", "", "Current job title")
+
+	// Job filters.
+	cmd.Flags().BoolVar(&jobsRemote, "remote", false, "Only remote jobs")
+	cmd.Flags().StringSliceVar(&jobsExperience, "experience", nil, "Experience levels: internship, entry, associate, mid-senior, director, executive")
+	cmd.Flags().StringVar(&jobsPostedWithin, "posted-within", "", "Only jobs posted within this age (e.g. 24h, 7d)")
+	cmd.Flags().StringArrayVar(&jobsCompanies, "company", nil, "Company name, URL or URN")
+
+	// Post filters.
+	cmd.Flags().StringVar(&savedSearchSort, "sort", "", "Sort order: recent or relevance")
+	cmd.Flags().StringVar(&postsDatePosted, "date-posted", "", "Only posts from past-24h, past-week or past-month")
+	cmd.Flags().StringArrayVar(&postsFromMembers, "from-member", nil, "Only posts by this member (username, URL or URN)")
+
+	return cmd
+}
+
+func runSearchSave(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	name, searchType, query := args[0], args[1], args[2]
+
+	search, err := buildSavedSearch(cmd, name, searchType, query)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	store, err := newSavedSearchStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	if _, err := store.Get(name); err == nil && !savedSearchForce {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput,
+			fmt.Sprintf("a search named %q already exists (use --force to replace it)", name))
+	} else if err != nil && !errors.Is(err, savedsearch.ErrNotFound) {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if err := store.Put(search); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*savedsearch.Search]{
+			Success: true,
+			Data:    search,
+		})
+	}
+
+	fmt.Printf("Saved %s search %q.\n", searchType, name)
+	fmt.Printf("Run it with: lnk search run %s\n", name)
+	return nil
+}
+
+// buildSavedSearch creates a saved search from the arguments and the filter
+// flags of its type. Flags of other types are rejected.
+func buildSavedSearch(cmd *cobra.Command, name, searchType, query string) (*savedsearch.Search, error) {
+	if err := savedsearch.ValidateName(name); err != nil {
+		return nil, err
+	}
+	if err := savedsearch.ValidateType(searchType); err != nil {
+		return nil, err
+	}
+
+	allowed := map[string]bool{"limit": true, "force": true}
+	for _, f := range savedSearchFlags[searchType] {
+		allowed[f] = true
+	}
+	for _, flags := range savedSearchFlags {
+		for _, f := range flags {
+			if cmd.Flags().Changed(f) && !allowed[f] {
+				return nil, fmt.Errorf("--%s does not apply to %s searches", f, searchType)
+			}
+		}
+	}
+
+	search := &savedsearch.Search{
+		Name:      name,
+		Type:      searchType,
+		Query:     query,
+		Limit:     savedSearchLimit,
+		CreatedAt: time.Now(),
+	}
+
+	switch searchType {
+	case savedsearch.TypePeople:
+		search.People = &api.PeopleFilters{
+			Network:          searchNetwork,
+			Locations:        searchLocations,
+			CurrentCompanies: searchCurrentCompany,
+			PastCompanies:    searchPastCompany,
+			Industries:       searchIndustries,
+			Schools:          searchSchools,
+			Title:            searchTitle,
+		}
+		for _, n := range searchNetwork {
+			if _, err := api.ParseNetwork(n); err != nil {
+				return nil, err
+			}
+		}
+	case savedsearch.TypeJobs:
+		if len(searchLocations) > 1 {
+			return nil, fmt.Errorf("job searches take a single --location")
+		}
+		search.Jobs = &api.JobSearchOptions{
+			Remote:     jobsRemote,
+			Experience: jobsExperience,
+			Companies:  jobsCompanies,
+		}
+		if len(searchLocations) == 1 {
+			search.Jobs.Location = searchLocations[0]
+		}
+		for _, e := range jobsExperience {
+			if _, err := api.ParseExperience(e); err != nil {
+				return nil, err
+			}
+		}
+		if jobsPostedWithin != "" {
+			age, err := parseAge(jobsPostedWithin)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --posted-within: %w", err)
+			}
+			search.Jobs.PostedWithin = age
+		}
+	case savedsearch.TypePosts:
+		search.Posts = &api.PostSearchOptions{
+			Sort:        savedSearchSort,
+			DatePosted:  postsDatePosted,
+			FromMembers: postsFromMembers,
+		}
+	}
+
+	return search, nil
+}
+
+func newSearchRunCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a saved search",
+		Long: `Run a saved search, print its results and record them for 'lnk search diff'.
+
+Example:
+  lnk search run ios`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSearchRun,
+	}
+}
+
+func runSearchRun(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	results, _, err := runAndRecordSearch(jsonOutput, args[0])
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]savedsearch.Result]{
+			Success: true,
+			Data:    results,
+		})
+	}
+
+	if len(results) == 0 {
+		fmt.Println("No results found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d results:\n\n", len(results))
+	for i, r := range results {
+		fmt.Printf("%d. %s\n", i+1, r.Title)
+		printSearchResultDetails(&r)
+		fmt.Println()
+	}
+	return nil
+}
+
+func newSearchDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <name>",
+		Short: "Run a saved search and report what changed since its last run",
+		Long: `Run a saved search and report which results appeared or disappeared since
+its last run. With --json only the new results are emitted, for piping
+into alerts.
+
+Examples:
+  lnk search diff ios
+  lnk search diff go-jobs --json | jq -r '.data[].url'`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSearchDiff,
+	}
+}
+
+func runSearchDiff(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	results, prev, err := runAndRecordSearch(jsonOutput, args[0])
+	if err != nil {
+		return err
+	}
+
+	var previous []savedsearch.Result
+	if prev != nil {
+		previous = prev.Results
+	}
+	added, removed := savedsearch.Diff(previous, results)
+
+	if jsonOutput {
+		if added == nil {
+			added = []savedsearch.Result{}
+		}
+		return outputJSON(api.Response[[]savedsearch.Result]{
+			Success: true,
+			Data:    added,
+		})
+	}
+
+	if prev == nil {
+		fmt.Printf("First run of %q: %d results recorded.\n", args[0], len(results))
+		return nil
+	}
+
+	fmt.Printf("Since %s: %d new, %d gone.\n", formatTime(prev.Time), len(added), len(removed))
+	for _, r := range added {
+		fmt.Printf("\n+ %s\n", r.Title)
+		printSearchResultDetails(&r)
+	}
+	for _, r := range removed {
+		fmt.Printf("\n- %s\n", r.Title)
+		printSearchResultDetails(&r)
+	}
+	return nil
+}
+
+func newSearchListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved searches",
+		Args:  cobra.NoArgs,
+		RunE:  runSearchList,
+	}
+}
+
+func runSearchList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newSavedSearchStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	searches, err := store.List()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]savedsearch.Search]{
+			Success: true,
+			Data:    searches,
+		})
+	}
+
+	if len(searches) == 0 {
+		fmt.Println("No saved searches.")
+		return nil
+	}
+
+	for _, s := range searches {
+		last := "never run"
+		if run := s.LastRun(); run != nil {
+			last = fmt.Sprintf("last run %s, %d results", formatTime(run.Time), len(run.Results))
+		}
+		fmt.Printf("%-20s %-9s %q (%s)\n", s.Name, s.Type, s.Query, last)
+	}
+	return nil
+}
+
+func newSearchDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a saved search and its history",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runSearchDelete,
+	}
+}
+
+func runSearchDelete(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	store, err := newSavedSearchStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	if err := store.Delete(args[0]); err != nil {
+		if errors.Is(err, savedsearch.ErrNotFound) {
+			return outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+		}
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success": true,
+			"name":    args[0],
+		})
+	}
+	fmt.Printf("Deleted saved search %q.\n", args[0])
+	return nil
+}
+
+// runAndRecordSearch executes the named search and records its results,
+// returning them with the previous run. Errors have already been output.
+func runAndRecordSearch(jsonOutput bool, name string) ([]savedsearch.Result, *savedsearch.Run, error) {
+	ctx := context.Background()
+
+	store, err := newSavedSearchStore()
+	if err != nil {
+		return nil, nil, outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	search, err := store.Get(name)
+	if err != nil {
+		if errors.Is(err, savedsearch.ErrNotFound) {
+			return nil, nil, outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+		}
+		return nil, nil, outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return nil, nil, outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	results, err := executeSavedSearch(ctx, client, search)
+	if err != nil {
+		return nil, nil, handleAPIError(jsonOutput, err)
+	}
+
+	prev, err := store.Record(name, savedsearch.Run{Time: time.Now(), Results: results})
+	if err != nil {
+		return nil, nil, outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	return results, prev, nil
+}
+
+// executeSavedSearch runs a saved search and converts its results.
+func executeSavedSearch(ctx context.Context, client *api.Client, s *savedsearch.Search) ([]savedsearch.Result, error) {
+	results := []savedsearch.Result{}
+	add := func(urn, title, subtitle, url string, v any) {
+		data, _ := json.Marshal(v)
+		results = append(results, savedsearch.Result{URN: urn, Title: title, Subtitle: subtitle, URL: url, Data: data})
+	}
+
+	switch s.Type {
+	case savedsearch.TypePeople:
+		filters, err := client.ResolvePeopleFilters(ctx, s.People)
+		if err != nil {
+			return nil, err
+		}
+		profiles, err := client.SearchPeople(ctx, s.Query, &api.SearchOptions{Limit: s.Limit, Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range profiles {
+			add(p.URN, searchProfileName(&p), p.Headline, p.ProfileURL, p)
+		}
+	case savedsearch.TypeCompanies:
+		companies, err := client.SearchCompanies(ctx, s.Query, &api.SearchOptions{Limit: s.Limit})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range companies {
+			add(c.URN, c.Name, c.Industry, c.CompanyURL, c)
+		}
+	case savedsearch.TypeJobs:
+		opts := api.JobSearchOptions{}
+		if s.Jobs != nil {
+			opts = *s.Jobs
+		}
+		opts.Limit = s.Limit
+		jobs, err := client.SearchJobs(ctx, s.Query, &opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, j := range jobs {
+			add(j.URN, j.Title, strings.Trim(j.CompanyName+" · "+j.Location, " ·"), j.URL, j)
+		}
+	case savedsearch.TypePosts:
+		opts := api.PostSearchOptions{}
+		if s.Posts != nil {
+			opts = *s.Posts
+		}
+		opts.Limit = s.Limit
+		posts, err := client.SearchPosts(ctx, s.Query, &opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range posts {
+			text := strings.Join(strings.Fields(p.Text), " ")
+			if r := []rune(text); len(r) > 100 {
+				text = string(r[:97]) + "..."
+			}
+			add(p.URN, p.AuthorName, text, "https://www.linkedin.com/feed/update/"+p.URN+"/", p)
+		}
+	default:
+		return nil, &api.Error{Code: api.ErrCodeInvalidInput, Message: fmt.Sprintf("unknown search type %q", s.Type)}
+	}
+
+	return results, nil
+}
+
+// printSearchResultDetails prints a saved search result's subtitle and link.
+func printSearchResultDetails(r *savedsearch.Result) {
+	if r.Subtitle != "" {
+		fmt.Printf("   %s\n", r.Subtitle)
+	}
+	if r.URL != "" {
+		fmt.Printf("   🔗 %s\n", r.URL)
+	}
+}
+
+// newSavedSearchStore opens the saved search store in the config directory.
+func newSavedSearchStore() (*savedsearch.Store, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return savedsearch.NewStore(dir), nil
+}
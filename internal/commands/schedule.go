@@ -0,0 +1,453 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/schedule"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheduleAt       string
+	scheduleFile     string
+	scheduleAll      bool
+	scheduleInterval time.Duration
+)
+
+// NewScheduleCmd creates the schedule command group.
+func NewScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Manage scheduled posts",
+		Long: `Commands for managing the local queue of scheduled posts.
+
+Posts are queued with 'lnk post schedule' and published by 'lnk schedule run'
+(for cron) or 'lnk schedule daemon' (long-running).`,
+	}
+
+	cmd.AddCommand(newScheduleListCmd())
+	cmd.AddCommand(newScheduleCancelCmd())
+	cmd.AddCommand(newScheduleRetryCmd())
+	cmd.AddCommand(newScheduleRunCmd())
+	cmd.AddCommand(newScheduleDaemonCmd())
+
+	return cmd
+}
+
+func newPostScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [text]",
+		Short: "Schedule a post for later",
+		Long: `Queue a post to be published at a later time.
+
+Times without a zone are interpreted in local time.
+
+Examples:
+  lnk post schedule --at 2026-11-01T09:00 "Good morning!"
+  lnk post schedule --at 2026-11-01T09:00 --file post.md`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runPostSchedule,
+	}
+
+	cmd.Flags().StringVar(&scheduleAt, "at", "", "When to publish (e.g. 2026-11-01T09:00)")
+	cmd.Flags().StringVarP(&scheduleFile, "file", "f", "", "Read post content from file")
+	_ = cmd.MarkFlagRequired("at")
+
+	return cmd
+}
+
+func runPostSchedule(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	text, err := readPostText(scheduleFile, args)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	at, err := schedule.ParseTime(scheduleAt, time.Local)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+	if at.Before(time.Now()) {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "scheduled time is in the past")
+	}
+
+	queue, err := newScheduleQueue()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	entry, err := queue.Add(text, at)
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*schedule.Entry]{
+			Success: true,
+			Data:    entry,
+		})
+	}
+
+	fmt.Println("Post scheduled.")
+	fmt.Printf("ID: %s\n", entry.ID)
+	fmt.Printf("At: %s\n", entry.At.Format("2006-01-02 15:04 MST"))
+	return nil
+}
+
+func newScheduleListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List scheduled posts",
+		Long: `List queued posts. By default only pending posts, posts being
+published and posts whose outcome is unknown are shown.
+
+A post is "unknown" when publishing failed in a way that LinkedIn may still
+have accepted it. A post stays "publishing" if the runner was killed while
+publishing it. Check your profile, then either cancel the post with
+'lnk schedule cancel <id>' or queue it again with 'lnk schedule retry <id>'.
+
+Examples:
+  lnk schedule list
+  lnk schedule list --all`,
+		RunE: runScheduleList,
+	}
+
+	cmd.Flags().BoolVarP(&scheduleAll, "all", "a", false, "Include published, failed and canceled posts")
+
+	return cmd
+}
+
+func runScheduleList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	queue, err := newScheduleQueue()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	entries, err := queue.Load()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if !scheduleAll {
+		pending := entries[:0]
+		for _, e := range entries {
+			switch e.Status {
+			case schedule.StatusPending, schedule.StatusPublishing, schedule.StatusUnknown:
+				pending = append(pending, e)
+			}
+		}
+		entries = pending
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]schedule.Entry]{
+			Success: true,
+			Data:    entries,
+		})
+	}
+
+	// Text output.
+	if len(entries) == 0 {
+		fmt.Println("No scheduled posts.")
+		return nil
+	}
+
+	for i, e := range entries {
+		if i > 0 {
+			fmt.Println("---")
+		}
+		fmt.Printf("ID: %s [%s]\n", e.ID, e.Status)
+		fmt.Printf("At: %s\n", e.At.Local().Format("2006-01-02 15:04 MST"))
+		text := e.Text
+		if len(text) > 200 {
+			text = text[:197] + "..."
+		}
+		fmt.Printf("Post: %s\n", text)
+		if e.URN != "" {
+			fmt.Printf("URN: %s\n", e.URN)
+		}
+		if e.LastError != "" {
+			fmt.Printf("Error: %s\n", e.LastError)
+		}
+	}
+
+	return nil
+}
+
+func newScheduleCancelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <id>",
+		Short: "Cancel a scheduled post",
+		Long: `Cancel a scheduled post by ID (or a unique ID prefix).
+
+Pending posts can be canceled, as can posts whose outcome is unknown and
+posts left "publishing" by a runner that was killed.
+
+Example:
+  lnk schedule cancel 3fa2c1d0`,
+		Args: cobra.ExactArgs(1),
+		RunE: runScheduleCancel,
+	}
+}
+
+func runScheduleCancel(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	queue, err := newScheduleQueue()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	entry, err := queue.Cancel(args[0])
+	if err != nil {
+		return scheduleUpdateError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*schedule.Entry]{
+			Success: true,
+			Data:    entry,
+		})
+	}
+
+	fmt.Printf("Canceled scheduled post %s.\n", entry.ID)
+	return nil
+}
+
+func newScheduleRetryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <id>",
+		Short: "Queue a failed or stuck post again",
+		Long: `Put a failed post, a post whose outcome is unknown, or a post left
+"publishing" by a runner that was killed back into the queue. It is published
+by the next 'lnk schedule run', or at its scheduled time if that is still
+ahead.
+
+LinkedIn may already have accepted unknown and publishing posts. Check your
+profile first: retrying such a post can publish it twice.
+
+Example:
+  lnk schedule retry 3fa2c1d0`,
+		Args: cobra.ExactArgs(1),
+		RunE: runScheduleRetry,
+	}
+}
+
+func runScheduleRetry(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	queue, err := newScheduleQueue()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	entries, err := queue.Load()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	entry, err := queue.Retry(args[0])
+	if err != nil {
+		return scheduleUpdateError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[*schedule.Entry]{
+			Success: true,
+			Data:    entry,
+		})
+	}
+
+	for _, e := range entries {
+		if e.ID == entry.ID && e.Status != schedule.StatusFailed {
+			fmt.Fprintf(os.Stderr, "Warning: post %s was %s; LinkedIn may already have published it, and retrying can post it twice.\n", e.ID, e.Status)
+		}
+	}
+	fmt.Printf("Queued scheduled post %s again.\n", entry.ID)
+	return nil
+}
+
+// scheduleUpdateError reports a failed cancel or retry.
+func scheduleUpdateError(jsonOutput bool, err error) error {
+	switch {
+	case errors.Is(err, schedule.ErrNotFound):
+		return outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+	case errors.Is(err, schedule.ErrLocked):
+		return outputError(jsonOutput, "SCHEDULE_ERROR", "a schedule runner is active and may be publishing this post; try again when it finishes")
+	}
+	return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+}
+
+func newScheduleRunCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "run",
+		Short: "Publish posts that are due",
+		Long: `Publish every scheduled post whose time has come, then exit.
+
+Suitable for cron:
+  */5 * * * * lnk schedule run`,
+		RunE: runScheduleRun,
+	}
+}
+
+func runScheduleRun(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	runner, err := newScheduleRunner(cmd, jsonOutput)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	outcomes, err := runner.RunDue(ctx)
+	if err != nil {
+		return outputError(jsonOutput, "SCHEDULE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]schedule.Outcome]{
+			Success: true,
+			Data:    outcomes,
+		})
+	}
+
+	if len(outcomes) == 0 {
+		fmt.Println("No posts due.")
+		return nil
+	}
+	for _, o := range outcomes {
+		printScheduleOutcome(o)
+	}
+
+	return nil
+}
+
+func newScheduleDaemonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Publish scheduled posts continuously",
+		Long: `Run in the foreground, publishing scheduled posts as they become due.
+
+A lock file ensures only one runner publishes at a time, so the daemon is
+safe to combine with 'lnk schedule run' from cron.
+
+Examples:
+  lnk schedule daemon
+  lnk schedule daemon --interval 30s`,
+		RunE: runScheduleDaemon,
+	}
+
+	cmd.Flags().DurationVar(&scheduleInterval, "interval", time.Minute, "How often to check for due posts")
+
+	return cmd
+}
+
+func runScheduleDaemon(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if scheduleInterval <= 0 {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "--interval must be positive")
+	}
+
+	runner, err := newScheduleRunner(cmd, jsonOutput)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	if !jsonOutput {
+		fmt.Fprintf(os.Stderr, "Watching schedule every %s. Press Ctrl-C to stop.\n", scheduleInterval)
+	}
+
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		outcomes, err := runner.RunDue(ctx)
+		switch {
+		case errors.Is(err, schedule.ErrLocked):
+			// Another runner is publishing; try again next tick.
+		case err != nil && ctx.Err() == nil:
+			fmt.Fprintf(os.Stderr, "schedule run failed: %v\n", err)
+		}
+
+		for _, o := range outcomes {
+			if jsonOutput {
+				_ = outputNDJSON(o)
+			} else {
+				printScheduleOutcome(o)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// newScheduleQueue opens the schedule queue in the config directory.
+func newScheduleQueue() (*schedule.Queue, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return schedule.NewQueue(dir), nil
+}
+
+// newScheduleRunner creates a runner that publishes with stored credentials.
+// With --dry-run the runner prints each post's request instead of sending it
+// and leaves the queue unchanged.
+func newScheduleRunner(cmd *cobra.Command, jsonOutput bool) (*schedule.Runner, error) {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	queue, err := newScheduleQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return nil, err
+	}
+
+	runner := schedule.NewRunner(queue, client)
+	if dryRun {
+		client.SetDryRun(func(r *api.DryRunRequest) {
+			printDryRun(jsonOutput, r)
+		})
+		runner.DryRun = true
+	}
+	return runner, nil
+}
+
+// printScheduleOutcome prints a single runner outcome in text mode.
+func printScheduleOutcome(o schedule.Outcome) {
+	if o.Status == schedule.StatusPending {
+		fmt.Printf("Would publish %s (dry run).\n", o.ID)
+		return
+	}
+	if o.Status == schedule.StatusPublished {
+		fmt.Printf("Published %s: %s\n", o.ID, o.URN)
+		return
+	}
+	if o.Status == schedule.StatusUnknown {
+		fmt.Printf("Unknown outcome for %s (check LinkedIn before retrying): %s\n", o.ID, o.Error)
+		return
+	}
+	fmt.Printf("Failed %s after %d attempt(s): %s\n", o.ID, o.Attempts, o.Error)
+}
@@ -3,23 +3,57 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pp/lnk/internal/api"
 	"github.com/spf13/cobra"
 )
 
-var searchLimit int
+var (
+	searchLimit int
+
+	searchNetwork        []string
+	searchLocations      []string
+	searchCurrentCompany []string
+	searchPastCompany    []string
+	searchIndustries     []string
+	searchSchools        []string
+	searchTitle          string
+
+	jobsLocation     string
+	jobsRemote       bool
+	jobsExperience   []string
+	jobsPostedWithin string
+	jobsCompanies    []string
+
+	postsSort        string
+	postsDatePosted  string
+	postsFromMembers []string
+)
 
 // NewSearchCmd creates the search command group.
 func NewSearchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "search",
 		Short: "Search LinkedIn",
-		Long:  `Search for people, companies, and jobs on LinkedIn.`,
+		Long:  `Search for people, companies, jobs and posts on LinkedIn.`,
 	}
 
 	cmd.AddCommand(newSearchPeopleCmd())
 	cmd.AddCommand(newSearchCompaniesCmd())
+	cmd.AddCommand(newSearchEntityCmd("schools", api.SearchSchools, "Search for schools and universities", `  lnk search schools "stanford"
+  lnk search schools "technical university" --limit 20`))
+	cmd.AddCommand(newSearchEntityCmd("groups", api.SearchGroups, "Search for groups", `  lnk search groups "golang"
+  lnk search groups "product management" --json`))
+	cmd.AddCommand(newSearchEntityCmd("events", api.SearchEvents, "Search for events", `  lnk search events "gophercon"
+  lnk search events "ai meetup" --limit 5`))
+	cmd.AddCommand(newSearchJobsCmd())
+	cmd.AddCommand(newSearchPostsCmd())
+	cmd.AddCommand(newSearchSaveCmd())
+	cmd.AddCommand(newSearchRunCmd())
+	cmd.AddCommand(newSearchDiffCmd())
+	cmd.AddCommand(newSearchListCmd())
+	cmd.AddCommand(newSearchDeleteCmd())
 
 	return cmd
 }
@@ -32,12 +66,24 @@ func newSearchPeopleCmd() *cobra.Command {
 
 Examples:
   lnk search people "software engineer"
-  lnk search people "product manager" --limit 20`,
+  lnk search people "product manager" --limit 20
+  lnk search people "recruiter" --network 1st,2nd --location Berlin
+  lnk search people "" --current-company acme --title "engineering manager"
+
+Locations, companies, industries and schools are looked up by name; a URN
+or numeric ID is used as is. Repeat a flag to match any of several values.`,
 		Args: cobra.ExactArgs(1),
 		RunE: runSearchPeople,
 	}
 
 	cmd.Flags().IntVarP(&searchLimit, "limit", "l", 10, "Maximum number of results")
+	cmd.Flags().StringSliceVar(&searchNetwork, "network", nil, "Connection degrees: 1st, 2nd, 3rd (comma-separated)")
+	cmd.Flags().StringArrayVar(&searchLocations, "location", nil, "Location name or geo URN")
+	cmd.Flags().StringArrayVar(&searchCurrentCompany, "current-company", nil, "Current company name, URL or URN")
+	cmd.Flags().StringArrayVar(&searchPastCompany, "past-company", nil, "Past company name, URL or URN")
+	cmd.Flags().StringArrayVar(&searchIndustries, "industry", nil, "Industry name or ID")
+	cmd.Flags().StringArrayVar(&searchSchools, "school", nil, "School name or URN")
+	cmd.Flags().StringVar(&searchTitle, "title", "", "Current job title")
 
 	return cmd
 }
@@ -53,8 +99,22 @@ func runSearchPeople(cmd *cobra.Command, args []string) error {
 		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
 	}
 
+	filters, err := client.ResolvePeopleFilters(ctx, &api.PeopleFilters{
+		Network:          searchNetwork,
+		Locations:        searchLocations,
+		CurrentCompanies: searchCurrentCompany,
+		PastCompanies:    searchPastCompany,
+		Industries:       searchIndustries,
+		Schools:          searchSchools,
+		Title:            searchTitle,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
 	opts := &api.SearchOptions{
-		Limit: searchLimit,
+		Limit:   searchLimit,
+		Filters: filters,
 	}
 
 	profiles, err := client.SearchPeople(ctx, query, opts)
@@ -77,13 +137,23 @@ func runSearchPeople(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d people:\n\n", len(profiles))
 	for i, p := range profiles {
-		fmt.Printf("%d. %s %s\n", i+1, p.FirstName, p.LastName)
+		name := searchProfileName(&p)
+		if p.Degree != "" {
+			name += " · " + p.Degree
+		}
+		if p.Premium {
+			name += " · Premium"
+		}
+		fmt.Printf("%d. %s\n", i+1, name)
 		if p.Headline != "" {
 			fmt.Printf("   %s\n", p.Headline)
 		}
 		if p.Location != "" {
 			fmt.Printf("   📍 %s\n", p.Location)
 		}
+		if p.MutualConnections > 0 {
+			fmt.Printf("   👥 %d mutual connections\n", p.MutualConnections)
+		}
 		if p.ProfileURL != "" {
 			fmt.Printf("   🔗 %s\n", p.ProfileURL)
 		}
@@ -93,6 +163,14 @@ func runSearchPeople(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// searchProfileName returns the name a people search result is shown as.
+func searchProfileName(p *api.Profile) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return strings.TrimSpace(p.FirstName + " " + p.LastName)
+}
+
 func newSearchCompaniesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "companies <query>",
@@ -171,3 +249,266 @@ func runSearchCompanies(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// newSearchEntityCmd creates a search subcommand for a result type that
+// has no filters of its own, such as schools, groups and events.
+func newSearchEntityCmd(name, resultType, short, examples string) *cobra.Command {
+	var limit int
+	cmd := &cobra.Command{
+		Use:   name + " <query>",
+		Short: short,
+		Long: short + ` on LinkedIn.
+
+Examples:
+` + examples,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchEntity(cmd, name, resultType, args[0], limit)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 10, "Maximum number of results")
+
+	return cmd
+}
+
+func runSearchEntity(cmd *cobra.Command, name, resultType, query string, limit int) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	results, err := client.Search(ctx, resultType, query, &api.SearchOptions{Limit: limit})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		data := make([]any, 0, len(results))
+		for _, r := range results {
+			switch {
+			case r.School != nil:
+				data = append(data, r.School)
+			case r.Group != nil:
+				data = append(data, r.Group)
+			case r.Event != nil:
+				data = append(data, r.Event)
+			}
+		}
+		return outputJSON(api.Response[[]any]{
+			Success: true,
+			Data:    data,
+		})
+	}
+
+	// Text output.
+	if len(results) == 0 {
+		fmt.Println("No results found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d %s:\n\n", len(results), name)
+	for i, r := range results {
+		var title, desc, link string
+		var details [][2]string // icon, value
+		switch {
+		case r.School != nil:
+			title, desc, link = r.School.Name, r.School.Description, r.School.URL
+			details = [][2]string{{"📍", r.School.Location}, {"👥", r.School.FollowerCount}}
+		case r.Group != nil:
+			title, desc, link = r.Group.Name, r.Group.Description, r.Group.URL
+			details = [][2]string{{"👥", r.Group.MemberCount}}
+		case r.Event != nil:
+			title, desc, link = r.Event.Name, r.Event.Description, r.Event.URL
+			details = [][2]string{{"📅", r.Event.Time}, {"📍", r.Event.Location}, {"👥", r.Event.Attendees}}
+		}
+
+		fmt.Printf("%d. %s\n", i+1, title)
+		for _, d := range details {
+			if d[1] != "" {
+				fmt.Printf("   %s %s\n", d[0], d[1])
+			}
+		}
+		if desc != "" {
+			if len(desc) > 100 {
+				desc = desc[:100] + "..."
+			}
+			fmt.Printf("   %s\n", desc)
+		}
+		if link != "" {
+			fmt.Printf("   🔗 %s\n", link)
+		}
+		fmt.Printf("   URN: %s\n", r.URN)
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func newSearchJobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs <keywords>",
+		Short: "Search for jobs",
+		Long: `Search for job postings on LinkedIn.
+
+Examples:
+  lnk search jobs "go engineer"
+  lnk search jobs "data scientist" --location Berlin --posted-within 7d
+  lnk search jobs "sre" --remote --experience mid-senior,director --company acme
+
+Use 'lnk job get <id>' for a posting's full description.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSearchJobs,
+	}
+
+	cmd.Flags().IntVarP(&searchLimit, "limit", "l", 10, "Maximum number of results")
+	cmd.Flags().StringVar(&jobsLocation, "location", "", "Location name or geo URN")
+	cmd.Flags().BoolVar(&jobsRemote, "remote", false, "Only remote jobs")
+	cmd.Flags().StringSliceVar(&jobsExperience, "experience", nil, "Experience levels: internship, entry, associate, mid-senior, director, executive")
+	cmd.Flags().StringVar(&jobsPostedWithin, "posted-within", "", "Only jobs posted within this age (e.g. 24h, 7d)")
+	cmd.Flags().StringArrayVar(&jobsCompanies, "company", nil, "Company name, URL or URN")
+
+	return cmd
+}
+
+func runSearchJobs(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	opts := &api.JobSearchOptions{
+		Limit:      searchLimit,
+		Location:   jobsLocation,
+		Remote:     jobsRemote,
+		Experience: jobsExperience,
+		Companies:  jobsCompanies,
+	}
+	if jobsPostedWithin != "" {
+		age, err := parseAge(jobsPostedWithin)
+		if err != nil {
+			return outputError(jsonOutput, api.ErrCodeInvalidInput, fmt.Sprintf("invalid --posted-within: %v", err))
+		}
+		opts.PostedWithin = age
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	jobs, err := client.SearchJobs(ctx, args[0], opts)
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.Job]{
+			Success: true,
+			Data:    jobs,
+		})
+	}
+
+	// Text output.
+	if len(jobs) == 0 {
+		fmt.Println("No results found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d jobs:\n\n", len(jobs))
+	for i, j := range jobs {
+		fmt.Printf("%d. %s\n", i+1, j.Title)
+		if j.CompanyName != "" {
+			fmt.Printf("   🏢 %s\n", j.CompanyName)
+		}
+		if j.Location != "" {
+			fmt.Printf("   📍 %s\n", j.Location)
+		}
+		if !j.PostedAt.IsZero() {
+			fmt.Printf("   Posted: %s\n", formatTime(j.PostedAt))
+		}
+		if j.URL != "" {
+			fmt.Printf("   🔗 %s\n", j.URL)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func newSearchPostsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts <query>",
+		Short: "Search for posts",
+		Long: `Search for posts mentioning a query on LinkedIn.
+
+Examples:
+  lnk search posts "lnk cli"
+  lnk search posts "acme" --sort recent --date-posted past-24h
+  lnk search posts "release" --from-member janedoe --limit 30`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSearchPosts,
+	}
+
+	cmd.Flags().IntVarP(&searchLimit, "limit", "l", 10, "Maximum number of results")
+	cmd.Flags().StringVar(&postsSort, "sort", api.SortRelevance, "Sort order: recent or relevance")
+	cmd.Flags().StringVar(&postsDatePosted, "date-posted", "", "Only posts from past-24h, past-week or past-month")
+	cmd.Flags().StringArrayVar(&postsFromMembers, "from-member", nil, "Only posts by this member (username, URL or URN)")
+
+	return cmd
+}
+
+func runSearchPosts(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	posts, err := client.SearchPosts(ctx, args[0], &api.PostSearchOptions{
+		Limit:       searchLimit,
+		Sort:        postsSort,
+		DatePosted:  postsDatePosted,
+		FromMembers: postsFromMembers,
+	})
+	if err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.Post]{
+			Success: true,
+			Data:    posts,
+		})
+	}
+
+	// Text output.
+	if len(posts) == 0 {
+		fmt.Println("No results found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d posts:\n\n", len(posts))
+	for i, p := range posts {
+		author := p.AuthorName
+		if author == "" {
+			author = "Unknown author"
+		}
+		fmt.Printf("%d. %s", i+1, author)
+		if !p.CreatedAt.IsZero() {
+			fmt.Printf(" · %s", formatTime(p.CreatedAt))
+		}
+		fmt.Println()
+		printFeedPost(&p, "   ")
+		if p.LikeCount > 0 || p.CommentCount > 0 || p.ShareCount > 0 {
+			fmt.Printf("   Reactions: %d, Comments: %d, Reposts: %d\n", p.LikeCount, p.CommentCount, p.ShareCount)
+		}
+		fmt.Printf("   URN: %s\n", p.URN)
+		fmt.Println()
+	}
+
+	return nil
+}
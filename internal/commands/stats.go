@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsTrack    bool
+	statsInterval time.Duration
+	statsFor      time.Duration
+	statsHistory  bool
+)
+
+func newPostStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats <urn-or-url>",
+		Short: "Show engagement for a post",
+		Long: `Show reactions, comments and reposts for a post, plus impressions and
+unique views when the post is your own.
+
+With --track, each snapshot is also appended to a local history database
+(~/.config/lnk/stats.db). Add --interval to keep sampling, for example to
+chart the first 48 hours after publishing.
+
+Examples:
+  lnk post stats "urn:li:activity:123456789"
+  lnk post stats "urn:li:activity:123456789" --track --interval 15m --for 48h
+  lnk post stats "urn:li:activity:123456789" --history --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runPostStats,
+	}
+
+	cmd.Flags().BoolVar(&statsTrack, "track", false, "Record snapshots in the local history database")
+	cmd.Flags().DurationVar(&statsInterval, "interval", 0, "With --track, keep sampling at this interval")
+	cmd.Flags().DurationVar(&statsFor, "for", 48*time.Hour, "With --interval, how long to keep sampling")
+	cmd.Flags().BoolVar(&statsHistory, "history", false, "Show recorded snapshots instead of fetching")
+
+	return cmd
+}
+
+func runPostStats(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	urn, err := api.ParseURN(args[0], api.RefPost)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+	if statsInterval < 0 || (statsInterval > 0 && !statsTrack) {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, "--interval requires --track and must be positive")
+	}
+
+	if statsHistory {
+		return showStatsHistory(jsonOutput, urn)
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+
+	var store *stats.Store
+	if statsTrack {
+		if store, err = openStatsStore(); err != nil {
+			return outputError(jsonOutput, "STORE_ERROR", err.Error())
+		}
+		defer store.Close()
+	}
+
+	deadline := time.Now().Add(statsFor)
+	for {
+		st, err := client.GetPostStats(ctx, urn)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return nil
+		case err != nil && statsInterval == 0:
+			return handleAPIError(jsonOutput, err)
+		case err != nil:
+			// Keep sampling through transient failures.
+			fmt.Fprintf(os.Stderr, "stats fetch failed: %v\n", err)
+		default:
+			if st.ViewsError != "" {
+				fmt.Fprintf(os.Stderr, "Warning: views unavailable: %s\n", st.ViewsError)
+			}
+			if store != nil {
+				if err := store.Record(st); err != nil {
+					return outputError(jsonOutput, "STORE_ERROR", err.Error())
+				}
+			}
+			if err := outputPostStats(jsonOutput, st); err != nil {
+				return err
+			}
+		}
+
+		if statsInterval == 0 || time.Now().Add(statsInterval).After(deadline) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(statsInterval):
+		}
+	}
+}
+
+// outputPostStats prints one stats snapshot. In JSON mode with --interval,
+// snapshots are written as NDJSON.
+func outputPostStats(jsonOutput bool, st *api.PostStats) error {
+	if jsonOutput {
+		if statsInterval > 0 {
+			return outputNDJSON(st)
+		}
+		return outputJSON(api.Response[*api.PostStats]{
+			Success: true,
+			Data:    st,
+		})
+	}
+
+	if statsInterval > 0 {
+		fmt.Printf("[%s] ", st.FetchedAt.Format("2006-01-02 15:04"))
+	}
+	fmt.Printf("Reactions: %d, Comments: %d, Reposts: %d", st.Likes, st.Comments, st.Shares)
+	if st.HasViews {
+		fmt.Printf(", Impressions: %d, Unique views: %d", st.Impressions, st.UniqueViews)
+	}
+	fmt.Println()
+
+	if statsInterval == 0 && len(st.Reactions) > 0 {
+		types := make([]string, 0, len(st.Reactions))
+		for t := range st.Reactions {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		for _, t := range types {
+			fmt.Printf("  %-12s %d\n", api.ReactionName(t), st.Reactions[t])
+		}
+	}
+	return nil
+}
+
+// showStatsHistory prints the recorded snapshots for a post.
+func showStatsHistory(jsonOutput bool, urn string) error {
+	store, err := openStatsStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+	defer store.Close()
+
+	history, err := store.History(urn)
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[[]api.PostStats]{
+			Success: true,
+			Data:    history,
+		})
+	}
+
+	if len(history) == 0 {
+		fmt.Println("No snapshots recorded. Use --track to record some.")
+		return nil
+	}
+
+	fmt.Printf("%-16s  %9s  %8s  %7s  %11s  %7s\n", "TIME", "REACTIONS", "COMMENTS", "REPOSTS", "IMPRESSIONS", "UNIQUE")
+	for _, st := range history {
+		fmt.Printf("%-16s  %9d  %8d  %7d  %11d  %7d\n",
+			st.FetchedAt.Format("2006-01-02 15:04"), st.Likes, st.Comments, st.Shares, st.Impressions, st.UniqueViews)
+	}
+	return nil
+}
+
+// openStatsStore opens the engagement history database.
+func openStatsStore() (*stats.Store, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return stats.Open(dir)
+}
@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/auth"
+	"github.com/pp/lnk/internal/undo"
+	"github.com/spf13/cobra"
+)
+
+// undoPollInterval is how often a waiting 'post create' checks whether
+// 'lnk undo' has deleted its post.
+const undoPollInterval = 500 * time.Millisecond
+
+// NewUndoCmd creates the undo command.
+func NewUndoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "undo",
+		Short: "Delete a post that is still in its undo window",
+		Long: `Delete the post most recently created with 'lnk post create --undo-window'
+while its window is still open.
+
+Undo acts immediately without a confirmation prompt.
+
+Example:
+  lnk post create --undo-window 30s "Hello LinkedIn!"
+  lnk undo`,
+		Args: cobra.NoArgs,
+		RunE: runUndo,
+	}
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	ctx := context.Background()
+
+	store, err := newUndoStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	record, err := store.Pending(time.Now())
+	if err != nil {
+		if errors.Is(err, undo.ErrNothing) {
+			return outputError(jsonOutput, api.ErrCodeNotFound, err.Error())
+		}
+		return outputError(jsonOutput, "STORE_ERROR", err.Error())
+	}
+
+	client, err := getAuthenticatedClient()
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+	}
+	if dryRun {
+		client.SetDryRun(func(r *api.DryRunRequest) {
+			printDryRun(jsonOutput, r)
+		})
+	}
+
+	if err := client.DeletePost(ctx, record.URN); err != nil {
+		return handleAPIError(jsonOutput, err)
+	}
+
+	record.Undone = true
+	if err := store.Save(record); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", fmt.Sprintf("post %s deleted but undo record not updated: %v", record.URN, err))
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]any{
+			"success": true,
+			"urn":     record.URN,
+			"message": "Post deleted",
+		})
+	}
+
+	fmt.Printf("Undone: deleted %s.\n", record.URN)
+	return nil
+}
+
+// undoWindowResult is the result of a post created with --undo-window.
+type undoWindowResult struct {
+	*api.Post
+	Undone bool `json:"undone"`
+}
+
+// runUndoWindow keeps a newly created post undoable for window. Ctrl-C
+// deletes the post; so does 'lnk undo' from another terminal.
+func runUndoWindow(ctx context.Context, jsonOutput bool, client *api.Client, post *api.Post, window time.Duration) error {
+	store, err := newUndoStore()
+	if err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", fmt.Sprintf("post published as %s but undo is unavailable: %v", post.URN, err))
+	}
+
+	now := time.Now()
+	record := &undo.Record{URN: post.URN, CreatedAt: now, ExpiresAt: now.Add(window)}
+	if err := store.Save(record); err != nil {
+		return outputError(jsonOutput, "STORE_ERROR", fmt.Sprintf("post published as %s but undo is unavailable: %v", post.URN, err))
+	}
+	defer func() { _ = store.Clear(post.URN) }()
+
+	if !jsonOutput {
+		fmt.Println("Post created successfully!")
+		fmt.Printf("URN: %s\n", post.URN)
+		fmt.Fprintf(os.Stderr, "Press Ctrl-C within %s to undo (or run 'lnk undo').\n", window)
+	}
+
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	ticker := time.NewTicker(undoPollInterval)
+	defer ticker.Stop()
+
+	undone := false
+wait:
+	for {
+		select {
+		case <-sigCtx.Done():
+			stop()
+			if err := client.DeletePost(ctx, post.URN); err != nil {
+				return handleAPIError(jsonOutput, err)
+			}
+			undone = true
+			break wait
+		case <-ticker.C:
+			if r, err := store.Load(); err == nil && r.URN == post.URN && r.Undone {
+				undone = true
+				break wait
+			}
+		case <-timer.C:
+			break wait
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[undoWindowResult]{
+			Success: true,
+			Data:    undoWindowResult{Post: post, Undone: undone},
+		})
+	}
+
+	if undone {
+		fmt.Println("Post undone and deleted.")
+	}
+	return nil
+}
+
+// newUndoStore opens the undo record in the config directory.
+func newUndoStore() (*undo.Store, error) {
+	dir, err := auth.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return undo.NewStore(dir), nil
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/urn"
+	"github.com/spf13/cobra"
+)
+
+var urnResolve bool
+
+// urnInfo describes a parsed URN and its equivalent forms.
+type urnInfo struct {
+	URN        string   `json:"urn"`
+	Kind       urn.Kind `json:"kind"`
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Pretty     string   `json:"pretty"`
+	Nested     []string `json:"nested,omitempty"`
+	Activity   string   `json:"activity,omitempty"`
+	Profile    string   `json:"profile,omitempty"`
+	Member     string   `json:"member,omitempty"`
+	PublicID   string   `json:"publicId,omitempty"`
+	Unresolved string   `json:"unresolved,omitempty"`
+}
+
+// NewURNCmd creates the urn command.
+func NewURNCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "urn <urn-or-url>",
+		Short: "Parse a LinkedIn URN or URL",
+		Long: `Parse and validate a LinkedIn URN or URL, and show its equivalent forms.
+
+Conversions that need the API (share or ugcPost to activity, member to
+fsd_profile and back) are only done with --resolve.
+
+Examples:
+  lnk urn "urn:li:fsd_update:(urn:li:activity:7123,MAIN_FEED,EMPTY,DEFAULT,false)"
+  lnk urn https://www.linkedin.com/in/ACoAAB1234xyz --resolve`,
+		Args: cobra.ExactArgs(1),
+		RunE: runURN,
+	}
+
+	cmd.Flags().BoolVar(&urnResolve, "resolve", false, "Use the API for conversions that need a lookup")
+
+	return cmd
+}
+
+func runURN(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	ctx := context.Background()
+
+	ref, err := api.ParseRef(args[0])
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+	if strings.HasPrefix(args[0], "urn:") {
+		// Keep composite URNs intact rather than the unwrapped activity.
+		ref.URN = strings.TrimSpace(args[0])
+	}
+
+	var lookup urn.Lookup
+	if urnResolve {
+		client, err := getAuthenticatedClient()
+		if err != nil {
+			return outputError(jsonOutput, api.ErrCodeAuthRequired, err.Error())
+		}
+		lookup = client
+
+		if ref.URN == "" && ref.PublicID != "" {
+			if ref.URN, err = client.ResolveURN(ctx, args[0]); err != nil {
+				return handleAPIError(jsonOutput, err)
+			}
+		}
+	}
+
+	if ref.URN == "" {
+		// A vanity URL we were not asked to look up.
+		info := urnInfo{Kind: ref.Kind, PublicID: ref.PublicID}
+		if jsonOutput {
+			return outputJSON(api.Response[urnInfo]{Success: true, Data: info})
+		}
+		fmt.Printf("Kind: %s\n", info.Kind)
+		fmt.Printf("Public ID: %s\n", info.PublicID)
+		if ref.Kind == api.RefProfile || ref.Kind == api.RefCompany {
+			fmt.Println("Use --resolve to look up its URN.")
+		}
+		return nil
+	}
+
+	u, err := urn.Parse(ref.URN)
+	if err != nil {
+		return outputError(jsonOutput, api.ErrCodeInvalidInput, err.Error())
+	}
+
+	info := urnInfo{
+		URN:      u.String(),
+		Kind:     u.Kind(),
+		Type:     u.Type,
+		ID:       u.ID,
+		Pretty:   u.Pretty(),
+		PublicID: ref.PublicID,
+	}
+	for _, n := range u.Nested() {
+		info.Nested = append(info.Nested, n.String())
+	}
+
+	switch u.Kind() {
+	case urn.KindPost:
+		a, err := urn.ToActivity(ctx, u, lookup)
+		info.Activity, info.Unresolved = conversion(a, err, info.Unresolved)
+	case urn.KindProfile:
+		p, err := urn.ToProfile(ctx, u, lookup)
+		info.Profile, info.Unresolved = conversion(p, err, info.Unresolved)
+		m, err := urn.ToMember(ctx, u, lookup)
+		info.Member, info.Unresolved = conversion(m, err, info.Unresolved)
+	}
+
+	if jsonOutput {
+		return outputJSON(api.Response[urnInfo]{Success: true, Data: info})
+	}
+
+	fmt.Printf("URN: %s\n", info.URN)
+	fmt.Printf("Kind: %s\n", info.Kind)
+	fmt.Printf("Pretty: %s\n", info.Pretty)
+	for _, n := range info.Nested {
+		fmt.Printf("Nested: %s\n", n)
+	}
+	if info.Activity != "" {
+		fmt.Printf("Activity: %s\n", info.Activity)
+	}
+	if info.Profile != "" {
+		fmt.Printf("Profile: %s\n", info.Profile)
+	}
+	if info.Member != "" {
+		fmt.Printf("Member: %s\n", info.Member)
+	}
+	if info.Unresolved != "" {
+		fmt.Printf("Unresolved: %s\n", info.Unresolved)
+	}
+	return nil
+}
+
+// conversion returns the converted URN, or records why it failed.
+func conversion(u urn.URN, err error, unresolved string) (string, string) {
+	if err == nil {
+		return u.String(), unresolved
+	}
+	if unresolved != "" {
+		unresolved += "; "
+	}
+	return "", unresolved + err.Error()
+}
@@ -0,0 +1,271 @@
+// Package draft provides a local workspace of post drafts stored as
+// Markdown files with YAML front matter.
+package draft
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/fsutil"
+	"github.com/pp/lnk/internal/idgen"
+	"gopkg.in/yaml.v3"
+)
+
+// DraftsDir is the directory name for drafts inside the config directory.
+const DraftsDir = "drafts"
+
+// frontMatterDelim separates the front matter from the draft body.
+const frontMatterDelim = "---"
+
+// Draft is a post that has not necessarily been published yet.
+type Draft struct {
+	ID          string    `yaml:"id" json:"id"`
+	Title       string    `yaml:"title,omitempty" json:"title,omitempty"`
+	Visibility  string    `yaml:"visibility,omitempty" json:"visibility,omitempty"`
+	Media       []string  `yaml:"media,omitempty" json:"media,omitempty"`
+	Account     string    `yaml:"account,omitempty" json:"account,omitempty"`
+	CreatedAt   time.Time `yaml:"created" json:"createdAt"`
+	UpdatedAt   time.Time `yaml:"updated" json:"updatedAt"`
+	PublishedAt time.Time `yaml:"published,omitempty" json:"publishedAt,omitempty"`
+	URN         string    `yaml:"urn,omitempty" json:"urn,omitempty"`
+	Body        string    `yaml:"-" json:"body"`
+}
+
+// Validate checks front matter that may have been edited by hand. The media
+// and account fields are reserved: attachments and publishing as another
+// account are not supported by the post API yet.
+func (d *Draft) Validate() error {
+	switch {
+	case len(d.Media) > 0:
+		return fmt.Errorf("drafts with media are not supported yet (remove the media field)")
+	case d.Account != "":
+		return fmt.Errorf("publishing as another account is not supported yet (remove the account field)")
+	}
+	return nil
+}
+
+// IsPublished reports whether the draft has been published.
+func (d *Draft) IsPublished() bool {
+	return d.URN != ""
+}
+
+// Summary returns the title, or the first line of the body if untitled.
+func (d *Draft) Summary() string {
+	if d.Title != "" {
+		return d.Title
+	}
+	line, _, _ := strings.Cut(strings.TrimSpace(d.Body), "\n")
+	return line
+}
+
+// Store manages drafts on disk.
+type Store struct {
+	dir string
+}
+
+// ErrNotFound indicates no draft matches the given ID.
+var ErrNotFound = errors.New("draft not found")
+
+// NewStore creates a draft store under the given config directory.
+func NewStore(configDir string) *Store {
+	return &Store{dir: filepath.Join(configDir, DraftsDir)}
+}
+
+// Path returns the file path for a draft ID.
+func (s *Store) Path(id string) string {
+	return filepath.Join(s.dir, id+".md")
+}
+
+// Create stores a new draft and returns it with its assigned ID. Drafts
+// that fail Validate are rejected.
+func (s *Store) Create(d *Draft) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	now := time.Now()
+	d.ID = idgen.New()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	return s.write(d)
+}
+
+// Save writes an existing draft, updating its modification time. Drafts
+// that fail Validate are rejected.
+func (s *Store) Save(d *Draft) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	d.UpdatedAt = time.Now()
+	return s.write(d)
+}
+
+// write encodes and writes a draft file atomically, so a crash never leaves
+// a truncated draft behind.
+func (s *Store) write(d *Draft) error {
+	data, err := Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	if err := fsutil.WriteFileAtomic(s.Path(d.ID), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write draft: %w", err)
+	}
+	return nil
+}
+
+// Get loads a draft by ID or unique ID prefix.
+func (s *Store) Get(id string) (*Draft, error) {
+	resolved, err := s.resolve(id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(s.Path(resolved))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read draft: %w", err)
+	}
+
+	d, err := Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	// The filename is authoritative if the front matter was edited.
+	d.ID = resolved
+	return d, nil
+}
+
+// List returns all drafts, most recently updated first.
+func (s *Store) List() ([]Draft, error) {
+	ids, err := s.ids()
+	if err != nil {
+		return nil, err
+	}
+
+	drafts := make([]Draft, 0, len(ids))
+	for _, id := range ids {
+		d, err := s.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("draft %s: %w", id, err)
+		}
+		drafts = append(drafts, *d)
+	}
+
+	sort.SliceStable(drafts, func(i, j int) bool {
+		return drafts[i].UpdatedAt.After(drafts[j].UpdatedAt)
+	})
+	return drafts, nil
+}
+
+// Delete removes a draft by ID or unique ID prefix.
+func (s *Store) Delete(id string) error {
+	resolved, err := s.resolve(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(s.Path(resolved)); err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+	return nil
+}
+
+// resolve maps an ID or unique prefix to a stored draft ID.
+func (s *Store) resolve(id string) (string, error) {
+	ids, err := s.ids()
+	if err != nil {
+		return "", err
+	}
+
+	match := ""
+	for _, candidate := range ids {
+		if candidate == id {
+			return id, nil
+		}
+		if strings.HasPrefix(candidate, id) {
+			if match != "" {
+				return "", fmt.Errorf("draft ID %q is ambiguous", id)
+			}
+			match = candidate
+		}
+	}
+	if match == "" {
+		return "", ErrNotFound
+	}
+	return match, nil
+}
+
+// ids lists the IDs of all stored drafts.
+func (s *Store) ids() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read drafts: %w", err)
+	}
+
+	var ids []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".md" {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(e.Name(), ".md"))
+	}
+	return ids, nil
+}
+
+// Marshal encodes a draft as front-matter Markdown.
+func Marshal(d *Draft) ([]byte, error) {
+	meta, err := yaml.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode draft: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelim + "\n")
+	buf.Write(meta)
+	buf.WriteString(frontMatterDelim + "\n")
+	buf.WriteString(d.Body)
+	if !strings.HasSuffix(d.Body, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes front-matter Markdown into a draft. Files without front
+// matter are treated as a body with no metadata.
+func Unmarshal(data []byte) (*Draft, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	d := &Draft{}
+
+	if !strings.HasPrefix(text, frontMatterDelim+"\n") {
+		d.Body = strings.TrimSpace(text)
+		return d, nil
+	}
+
+	rest := text[len(frontMatterDelim)+1:]
+	meta, body, found := strings.Cut(rest, "\n"+frontMatterDelim+"\n")
+	if after, ok := strings.CutPrefix(rest, frontMatterDelim+"\n"); ok {
+		// Empty front matter.
+		meta, body, found = "", after, true
+	}
+	if !found {
+		// Allow a closing delimiter at EOF with no trailing newline.
+		meta, found = strings.CutSuffix(rest, "\n"+frontMatterDelim)
+		if !found {
+			return nil, fmt.Errorf("unterminated front matter")
+		}
+		body = ""
+	}
+
+	if err := yaml.Unmarshal([]byte(meta), d); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	d.Body = strings.TrimSpace(body)
+	return d, nil
+}
@@ -0,0 +1,188 @@
+package draft
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	d := &Draft{
+		ID:         "abcd1234",
+		Title:      "Launch",
+		Visibility: "connections",
+		Media:      []string{"hero.png", "chart.png"},
+		Body:       "We shipped it!\n\nDetails below.",
+	}
+
+	data, err := Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+
+	got, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+
+	if got.Title != d.Title || got.Visibility != d.Visibility {
+		t.Errorf("metadata = %+v, want %+v", got, d)
+	}
+	if len(got.Media) != 2 || got.Media[1] != "chart.png" {
+		t.Errorf("Media = %v, want %v", got.Media, d.Media)
+	}
+	if got.Body != d.Body {
+		t.Errorf("Body = %q, want %q", got.Body, d.Body)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantBody  string
+		wantTitle string
+		wantErr   bool
+	}{
+		{
+			name:     "no front matter",
+			input:    "Just a post\n",
+			wantBody: "Just a post",
+		},
+		{
+			name:     "empty front matter",
+			input:    "---\n---\nBody text\n",
+			wantBody: "Body text",
+		},
+		{
+			name:      "front matter without body",
+			input:     "---\ntitle: Hello\n---",
+			wantTitle: "Hello",
+		},
+		{
+			name:      "body containing a rule",
+			input:     "---\ntitle: x\n---\nabove\n---\nbelow\n",
+			wantBody:  "above\n---\nbelow",
+			wantTitle: "x",
+		},
+		{
+			name:    "unterminated",
+			input:   "---\ntitle: x\nbody",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Unmarshal([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Body != tt.wantBody {
+				t.Errorf("Body = %q, want %q", d.Body, tt.wantBody)
+			}
+			if d.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", d.Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	s := NewStore(t.TempDir())
+
+	d := &Draft{Body: "first draft"}
+	if err := s.Create(d); err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+	if d.ID == "" {
+		t.Fatal("Create() did not assign an ID")
+	}
+
+	info, err := os.Stat(s.Path(d.ID))
+	if err != nil {
+		t.Fatalf("Stat() error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("permissions = %o, want 600", info.Mode().Perm())
+	}
+
+	got, err := s.Get(d.ID[:3])
+	if err != nil {
+		t.Fatalf("Get(prefix) error: %v", err)
+	}
+	if got.Body != "first draft" {
+		t.Errorf("Body = %q, want %q", got.Body, "first draft")
+	}
+
+	got.URN = "urn:li:share:1"
+	if err := s.Save(got); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	drafts, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(drafts) != 1 || !drafts[0].IsPublished() {
+		t.Errorf("List() = %+v, want one published draft", drafts)
+	}
+
+	if _, err := os.Stat(s.Path(d.ID) + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temp file left behind: %v", err)
+	}
+
+	if err := s.Delete(d.ID); err != nil {
+		t.Fatalf("Delete() error: %v", err)
+	}
+	if _, err := s.Get(d.ID); err != ErrNotFound {
+		t.Errorf("Get() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStoreRejectsUnsupportedFields(t *testing.T) {
+	s := NewStore(t.TempDir())
+
+	if err := s.Create(&Draft{Body: "launch", Media: []string{"hero.png"}}); err == nil {
+		t.Error("Create() accepted a draft with media")
+	}
+	if err := s.Create(&Draft{Body: "launch", Account: "urn:li:fsd_company:1"}); err == nil {
+		t.Error("Create() accepted a draft with an account")
+	}
+	if ids, _ := s.ids(); len(ids) != 0 {
+		t.Errorf("rejected drafts were written: %v", ids)
+	}
+
+	d := &Draft{Body: "launch"}
+	if err := s.Create(d); err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+
+	// A hand-edited file is still readable, so it can be fixed, but it
+	// cannot be saved, and so never published, until the fields are removed.
+	data, err := os.ReadFile(s.Path(d.ID))
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	edited := strings.Replace(string(data), "id: ", "media:\n    - hero.png\nid: ", 1)
+	if err := os.WriteFile(s.Path(d.ID), []byte(edited), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	got, err := s.Get(d.ID)
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	if len(got.Media) != 1 || got.Validate() == nil {
+		t.Errorf("Get() = %+v, want a draft that fails Validate", got)
+	}
+	got.URN = "urn:li:share:1"
+	if err := s.Save(got); err == nil {
+		t.Error("Save() accepted a draft with media")
+	}
+}
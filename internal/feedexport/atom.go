@@ -0,0 +1,117 @@
+package feedexport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const atomNS = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+func writeAtom(w io.Writer, feed Feed, entries []Entry) error {
+	doc := atomFeed{
+		ID:      feed.Link,
+		Title:   feed.Title,
+		Updated: feed.Updated.UTC().Format(time.RFC3339),
+		Link:    []atomLink{{Href: feed.Link, Rel: "alternate"}},
+		// Atom requires an author on the feed or on every entry.
+		Author: &atomAuthor{Name: "LinkedIn"},
+	}
+	for _, e := range entries {
+		published := entryTime(e, feed).Format(time.RFC3339)
+		ae := atomEntry{
+			ID:        e.ID,
+			Title:     e.Title,
+			Link:      atomLink{Href: e.Link, Rel: "alternate"},
+			Published: published,
+			Updated:   published,
+			Content:   atomContent{Type: "html", Body: e.Content},
+		}
+		if e.Author != "" {
+			ae.Author = &atomAuthor{Name: e.Author, URI: e.AuthorURL}
+		}
+		doc.Entries = append(doc.Entries, ae)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write Atom feed: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readAtom(r io.Reader) ([]Entry, error) {
+	var doc atomFeed
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse Atom feed: %w", err)
+	}
+	if doc.XMLName.Space != atomNS {
+		return nil, fmt.Errorf("not an Atom feed")
+	}
+
+	entries := make([]Entry, 0, len(doc.Entries))
+	for _, ae := range doc.Entries {
+		e := Entry{
+			ID:      ae.ID,
+			Title:   ae.Title,
+			Link:    ae.Link.Href,
+			Content: ae.Content.Body,
+		}
+		e.Published, _ = time.Parse(time.RFC3339, ae.Published)
+		if ae.Author != nil {
+			e.Author, e.AuthorURL = ae.Author.Name, ae.Author.URI
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// entryTime returns the entry's publication time, falling back to the
+// feed's update time when LinkedIn gave none.
+func entryTime(e Entry, feed Feed) time.Time {
+	if e.Published.IsZero() {
+		return feed.Updated.UTC()
+	}
+	return e.Published.UTC()
+}
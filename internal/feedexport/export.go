@@ -0,0 +1,253 @@
+// Package feedexport renders feed items as Atom, RSS or a self-contained
+// HTML digest, and merges new items into a previous export.
+package feedexport
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+// Export formats.
+const (
+	FormatAtom = "atom"
+	FormatRSS  = "rss"
+	FormatHTML = "html"
+)
+
+// DefaultMaxEntries caps how many entries a merged export keeps.
+const DefaultMaxEntries = 200
+
+// Feed describes the exported document.
+type Feed struct {
+	Title   string
+	Link    string
+	Updated time.Time
+}
+
+// DefaultFeed is the document metadata used by lnk.
+func DefaultFeed() Feed {
+	return Feed{
+		Title:   "LinkedIn feed",
+		Link:    "https://www.linkedin.com/feed/",
+		Updated: time.Now().UTC(),
+	}
+}
+
+// Entry is an exported feed item. Content is HTML.
+type Entry struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Link      string    `json:"link"`
+	Author    string    `json:"author,omitempty"`
+	AuthorURL string    `json:"authorUrl,omitempty"`
+	Published time.Time `json:"published"`
+	Content   string    `json:"content"`
+}
+
+// titleLength is the maximum length of an entry title, in runes.
+const titleLength = 80
+
+// FromItem converts a feed item into an entry.
+func FromItem(item *api.FeedItem) Entry {
+	e := Entry{
+		ID:        item.URN,
+		Link:      "https://www.linkedin.com/feed/update/" + item.URN + "/",
+		Published: item.CreatedAt.UTC(),
+	}
+	if item.Post != nil && item.Post.URN != "" {
+		e.ID = item.Post.URN
+		e.Link = "https://www.linkedin.com/feed/update/" + item.Post.URN + "/"
+	}
+	if item.Actor != nil {
+		e.Author = item.Actor.Name
+		e.AuthorURL = item.Actor.URL
+	}
+
+	var text string
+	if item.Post != nil {
+		text = item.Post.Text
+		if text == "" && item.Post.Reshared != nil {
+			text = item.Post.Reshared.Text
+		}
+	}
+	e.Title = title(e.Author, text)
+
+	var b strings.Builder
+	if item.Reason != "" {
+		fmt.Fprintf(&b, "<p><em>%s</em></p>\n", html.EscapeString(item.Reason))
+	}
+	if item.Post != nil {
+		writePost(&b, item.Post)
+		if r := item.Post.Reshared; r != nil {
+			b.WriteString("<blockquote>\n")
+			if r.AuthorName != "" {
+				fmt.Fprintf(&b, "<p><strong>%s</strong></p>\n", html.EscapeString(r.AuthorName))
+			}
+			writePost(&b, r)
+			b.WriteString("</blockquote>\n")
+		}
+	}
+	e.Content = b.String()
+
+	return e
+}
+
+// title builds an entry title from the author and the start of the text.
+func title(author, text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if r := []rune(text); len(r) > titleLength {
+		text = string(r[:titleLength-1]) + "…"
+	}
+	switch {
+	case author != "" && text != "":
+		return author + ": " + text
+	case author != "":
+		return author
+	case text != "":
+		return text
+	default:
+		return "LinkedIn update"
+	}
+}
+
+// writePost renders a post's text and attachments as HTML.
+func writePost(b *strings.Builder, p *api.Post) {
+	if p.Text != "" {
+		paragraphs := strings.Split(strings.TrimSpace(p.Text), "\n\n")
+		for _, para := range paragraphs {
+			escaped := html.EscapeString(para)
+			fmt.Fprintf(b, "<p>%s</p>\n", strings.ReplaceAll(escaped, "\n", "<br>"))
+		}
+	}
+	if p.Poll != nil {
+		fmt.Fprintf(b, "<p>Poll: %s</p>\n<ul>\n", html.EscapeString(p.Poll.Question))
+		for _, o := range p.Poll.Options {
+			fmt.Fprintf(b, "<li>%s</li>\n", html.EscapeString(o.Text))
+		}
+		b.WriteString("</ul>\n")
+	}
+	for _, m := range p.Media {
+		// Only http and https URLs become links or images.
+		url := html.EscapeString(safeURL(m.URL))
+		if url == "" {
+			continue
+		}
+		switch m.Type {
+		case api.MediaImage:
+			fmt.Fprintf(b, "<p><img src=\"%s\" alt=\"\"></p>\n", url)
+		case api.MediaVideo:
+			if thumb := safeURL(m.ThumbnailURL); thumb != "" {
+				fmt.Fprintf(b, "<p><a href=\"%s\"><img src=\"%s\" alt=\"Video\"></a></p>\n", url, html.EscapeString(thumb))
+			} else {
+				fmt.Fprintf(b, "<p><a href=\"%s\">Video</a></p>\n", url)
+			}
+		default:
+			label := m.Title
+			if label == "" {
+				label = "Document"
+			}
+			fmt.Fprintf(b, "<p><a href=\"%s\">%s</a></p>\n", url, html.EscapeString(label))
+		}
+	}
+	if a := p.Article; a != nil && safeURL(a.URL) != "" {
+		label := a.Title
+		if label == "" {
+			label = a.URL
+		}
+		fmt.Fprintf(b, "<p><a href=\"%s\">%s</a>", html.EscapeString(a.URL), html.EscapeString(label))
+		if a.Subtitle != "" {
+			fmt.Fprintf(b, " <small>%s</small>", html.EscapeString(a.Subtitle))
+		}
+		b.WriteString("</p>\n")
+	}
+}
+
+// Merge combines a previous export with entries fetched at time fetched.
+// New entries replace old ones with the same ID. Fresh entries without a
+// timestamp keep the one already exported for their ID, or get fetched, so
+// they are not the first to be cut. The result is newest first and holds at
+// most max entries (no limit if max <= 0).
+func Merge(previous, fresh []Entry, fetched time.Time, max int) []Entry {
+	byID := make(map[string]int, len(previous)+len(fresh))
+	var merged []Entry
+	for _, e := range previous {
+		if i, ok := byID[e.ID]; ok {
+			merged[i] = e
+			continue
+		}
+		byID[e.ID] = len(merged)
+		merged = append(merged, e)
+	}
+	for _, e := range fresh {
+		i, ok := byID[e.ID]
+		if e.Published.IsZero() {
+			e.Published = fetched
+			if ok && !merged[i].Published.IsZero() {
+				e.Published = merged[i].Published
+			}
+		}
+		if ok {
+			merged[i] = e
+			continue
+		}
+		byID[e.ID] = len(merged)
+		merged = append(merged, e)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Published.After(merged[j].Published)
+	})
+	if max > 0 && len(merged) > max {
+		merged = merged[:max]
+	}
+	return merged
+}
+
+// Write renders entries in the given format.
+func Write(w io.Writer, feed Feed, entries []Entry, format string) error {
+	switch format {
+	case FormatAtom:
+		return writeAtom(w, feed, entries)
+	case FormatRSS:
+		return writeRSS(w, feed, entries)
+	case FormatHTML:
+		return writeHTML(w, feed, entries)
+	default:
+		return fmt.Errorf("invalid format %q: use atom, rss or html", format)
+	}
+}
+
+// Read parses a document previously written in the given format. The file
+// may have been edited since, so entry content is sanitized and links that
+// are not http or https are dropped.
+func Read(r io.Reader, format string) ([]Entry, error) {
+	var entries []Entry
+	var err error
+	switch format {
+	case FormatAtom:
+		entries, err = readAtom(r)
+	case FormatRSS:
+		entries, err = readRSS(r)
+	case FormatHTML:
+		entries, err = readHTML(r)
+	default:
+		return nil, fmt.Errorf("invalid format %q: use atom, rss or html", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		e.Content = sanitizeContent(e.Content)
+		e.Link = safeURL(e.Link)
+		e.AuthorURL = safeURL(e.AuthorURL)
+	}
+	return entries, nil
+}
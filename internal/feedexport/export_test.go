@@ -0,0 +1,282 @@
+package feedexport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+func testItem() *api.FeedItem {
+	return &api.FeedItem{
+		URN:       "urn:li:activity:1",
+		Type:      api.FeedItemPost,
+		CreatedAt: time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+		Actor:     &api.Actor{Name: "Jane Doe", URL: "https://www.linkedin.com/in/janedoe"},
+		Post: &api.Post{
+			URN:   "urn:li:activity:1",
+			Text:  "Hiring <Go> engineers & SREs\nApply today",
+			Media: []api.Media{{Type: api.MediaImage, URL: "https://media.example/1.jpg"}},
+			Article: &api.Article{
+				URL:   "https://example.com/jobs",
+				Title: "Jobs at Acme",
+			},
+		},
+	}
+}
+
+func TestFromItem(t *testing.T) {
+	e := FromItem(testItem())
+
+	if e.ID != "urn:li:activity:1" {
+		t.Errorf("ID = %q", e.ID)
+	}
+	if e.Link != "https://www.linkedin.com/feed/update/urn:li:activity:1/" {
+		t.Errorf("Link = %q", e.Link)
+	}
+	if e.Title != "Jane Doe: Hiring <Go> engineers & SREs Apply today" {
+		t.Errorf("Title = %q", e.Title)
+	}
+	for _, want := range []string{
+		"Hiring &lt;Go&gt; engineers &amp; SREs<br>Apply today",
+		`<img src="https://media.example/1.jpg"`,
+		`<a href="https://example.com/jobs">Jobs at Acme</a>`,
+	} {
+		if !strings.Contains(e.Content, want) {
+			t.Errorf("Content missing %q:\n%s", want, e.Content)
+		}
+	}
+}
+
+func TestFromItemReshare(t *testing.T) {
+	item := &api.FeedItem{
+		URN:   "urn:li:activity:2",
+		Actor: &api.Actor{Name: "John Smith"},
+		Post: &api.Post{
+			Reshared: &api.Post{AuthorName: "Jane Doe", Text: "Original post"},
+		},
+	}
+	e := FromItem(item)
+
+	if e.Title != "John Smith: Original post" {
+		t.Errorf("Title = %q", e.Title)
+	}
+	if !strings.Contains(e.Content, "<blockquote>") || !strings.Contains(e.Content, "Jane Doe") {
+		t.Errorf("Content = %q", e.Content)
+	}
+}
+
+func TestFromItemDropsUnsafeURLs(t *testing.T) {
+	item := testItem()
+	item.Post.Media = []api.Media{
+		{Type: api.MediaImage, URL: "data:image/svg+xml;base64,PHN2Zz4="},
+		{Type: api.MediaVideo, URL: "https://media.example/2.mp4", ThumbnailURL: "javascript:alert(1)"},
+		{Type: api.MediaDocument, URL: " JavaScript:alert(1)", Title: "Deck"},
+	}
+	item.Post.Article = &api.Article{URL: "javascript:alert(document.cookie)", Title: "Click me"}
+
+	e := FromItem(item)
+	for _, bad := range []string{"javascript", "JavaScript", "data:", "Click me", "Deck"} {
+		if strings.Contains(e.Content, bad) {
+			t.Errorf("Content contains %q:\n%s", bad, e.Content)
+		}
+	}
+	if !strings.Contains(e.Content, `<a href="https://media.example/2.mp4">Video</a>`) {
+		t.Errorf("Content lost the safe video link:\n%s", e.Content)
+	}
+}
+
+func TestTitleTruncates(t *testing.T) {
+	got := title("", strings.Repeat("é", 100))
+	if n := len([]rune(got)); n != titleLength {
+		t.Errorf("title length = %d, want %d", n, titleLength)
+	}
+	if !strings.HasSuffix(got, "…") {
+		t.Errorf("title = %q, want ellipsis", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	previous := []Entry{
+		{ID: "a", Title: "old a", Published: day(1)},
+		{ID: "b", Title: "b", Published: day(2)},
+	}
+	fresh := []Entry{
+		{ID: "c", Title: "c", Published: day(3)},
+		{ID: "a", Title: "new a", Published: day(1)},
+	}
+
+	got := Merge(previous, fresh, day(4), 0)
+	var ids []string
+	for _, e := range got {
+		ids = append(ids, e.ID)
+	}
+	if strings.Join(ids, ",") != "c,b,a" {
+		t.Errorf("order = %v, want c,b,a", ids)
+	}
+	if got[2].Title != "new a" {
+		t.Errorf("duplicate not replaced: %q", got[2].Title)
+	}
+
+	if got := Merge(previous, fresh, day(4), 2); len(got) != 2 || got[1].ID != "b" {
+		t.Errorf("Merge with max 2 = %+v", got)
+	}
+}
+
+func TestMergeStampsMissingTimes(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	previous := []Entry{
+		{ID: "a", Published: day(1)},
+		{ID: "b", Published: day(2)},
+		{ID: "c", Published: day(3)},
+	}
+	fresh := []Entry{
+		{ID: "new"},
+		{ID: "b"},
+	}
+
+	got := Merge(previous, fresh, day(5), 2)
+	if len(got) != 2 || got[0].ID != "new" || !got[0].Published.Equal(day(5)) {
+		t.Fatalf("Merge = %+v, want the fresh entry first, stamped with the fetch time", got)
+	}
+	if got[1].ID != "c" {
+		t.Errorf("second entry = %q, want c", got[1].ID)
+	}
+
+	got = Merge(previous, fresh, day(5), 0)
+	for _, e := range got {
+		if e.ID == "b" && !e.Published.Equal(day(2)) {
+			t.Errorf("b.Published = %v, want its previous time %v", e.Published, day(2))
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	feed := Feed{Title: "Test", Link: "https://www.linkedin.com/feed/", Updated: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)}
+	want := FromItem(testItem())
+
+	for _, format := range []string{FormatAtom, FormatRSS, FormatHTML} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, feed, []Entry{want}, format); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+
+			if format != FormatHTML {
+				// The document must be well-formed XML.
+				dec := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+				for {
+					if _, err := dec.Token(); err != nil {
+						if !errors.Is(err, io.EOF) {
+							t.Fatalf("invalid XML: %v", err)
+						}
+						break
+					}
+				}
+			}
+
+			got, err := Read(&buf, format)
+			if err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+			if len(got) != 1 {
+				t.Fatalf("got %d entries, want 1", len(got))
+			}
+			e := got[0]
+			if e.ID != want.ID || e.Title != want.Title || e.Link != want.Link || e.Author != want.Author {
+				t.Errorf("got %+v, want %+v", e, want)
+			}
+			if !e.Published.Equal(want.Published) {
+				t.Errorf("Published = %v, want %v", e.Published, want.Published)
+			}
+			if e.Content != want.Content {
+				t.Errorf("Content = %q, want %q", e.Content, want.Content)
+			}
+		})
+	}
+}
+
+func TestHTMLDigest(t *testing.T) {
+	var buf bytes.Buffer
+	feed := Feed{Title: "Digest", Link: "https://www.linkedin.com/feed/", Updated: time.Now()}
+	if err := Write(&buf, feed, []Entry{FromItem(testItem())}, FormatHTML); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{"<!DOCTYPE html>", "<style>", "Hiring &lt;Go&gt;", `href="https://www.linkedin.com/feed/update/urn:li:activity:1/"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("digest missing %q", want)
+		}
+	}
+	if strings.Contains(out, "Hiring <Go>") {
+		t.Error("digest contains unescaped post text")
+	}
+}
+
+func TestReadSanitizesContent(t *testing.T) {
+	// An export edited by hand, or by someone else, must not get its markup
+	// trusted by the next digest.
+	tampered := Entry{
+		ID:        "urn:li:activity:9",
+		Title:     "Tampered",
+		Link:      "javascript:alert(1)",
+		AuthorURL: "data:text/html,hi",
+		Published: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Content: `<p><a href="javascript:alert(1)">x</a> <a href="java&#115;cript:alert(1)">y</a></p>` +
+			`<script>alert(2)</script><img src="https://media.example/1.jpg" onerror="alert(3)">` +
+			`<p><a href="https://example.com/?a=1&amp;b=2">ok</a><br></p>`,
+	}
+
+	for _, format := range []string{FormatAtom, FormatRSS, FormatHTML} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, DefaultFeed(), []Entry{tampered}, format); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			got, err := Read(&buf, format)
+			if err != nil || len(got) != 1 {
+				t.Fatalf("Read() = %+v, %v", got, err)
+			}
+			e := got[0]
+			if e.Link != "" || e.AuthorURL != "" {
+				t.Errorf("unsafe links kept: %q, %q", e.Link, e.AuthorURL)
+			}
+			for _, bad := range []string{"<script", "<a href=\"java", "<img"} {
+				if strings.Contains(e.Content, bad) {
+					t.Errorf("Content contains %q:\n%s", bad, e.Content)
+				}
+			}
+			if !strings.Contains(e.Content, `<p><a href="https://example.com/?a=1&amp;b=2">ok</a><br></p>`) {
+				t.Errorf("Content lost safe markup:\n%s", e.Content)
+			}
+
+			var digest bytes.Buffer
+			if err := Write(&digest, DefaultFeed(), got, FormatHTML); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			body, _, _ := strings.Cut(digest.String(), `<script type="application/json"`)
+			if strings.Contains(body, "<script>") || strings.Contains(body, `<a href="javascript`) || strings.Contains(body, "<img") {
+				t.Errorf("digest contains live markup from the tampered export:\n%s", body)
+			}
+		})
+	}
+}
+
+func TestReadRejectsOtherDocuments(t *testing.T) {
+	if _, err := Read(strings.NewReader("<html><body></body></html>"), FormatHTML); err == nil {
+		t.Error("expected error for foreign HTML")
+	}
+	if _, err := Read(strings.NewReader(`<feed xmlns="http://example.com/"></feed>`), FormatAtom); err == nil {
+		t.Error("expected error for non-Atom XML")
+	}
+	if _, err := Read(strings.NewReader(""), "pdf"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
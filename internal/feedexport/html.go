@@ -0,0 +1,92 @@
+package feedexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// dataScriptID identifies the script element that carries the entries, so
+// a later run can merge with the digest.
+const dataScriptID = "lnk-feed-data"
+
+var digestTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Feed.Title}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; max-width: 44rem; margin: 2rem auto; padding: 0 1rem; color: #1d2226; background: #f4f2ee; }
+article { background: #fff; border-radius: 8px; padding: 1rem 1.25rem; margin: 1rem 0; box-shadow: 0 0 0 1px rgba(0,0,0,.08); }
+header { color: #666; font-size: .875rem; margin-bottom: .5rem; }
+header a { color: #0a66c2; font-weight: 600; text-decoration: none; }
+blockquote { border-left: 3px solid #ddd; margin: .5rem 0; padding-left: 1rem; }
+img { max-width: 100%; height: auto; border-radius: 4px; }
+footer { color: #666; font-size: .75rem; text-align: center; }
+</style>
+</head>
+<body>
+<h1>{{.Feed.Title}}</h1>
+<p>Updated {{.Feed.Updated.Format "2006-01-02 15:04 MST"}}</p>
+{{range .Entries}}<article id="{{.ID}}">
+<header>{{if .AuthorURL}}<a href="{{.AuthorURL}}">{{.Author}}</a>{{else}}<strong>{{.Author}}</strong>{{end}}{{if not .Published.IsZero}} · {{.Published.Format "2006-01-02 15:04"}}{{end}} · <a href="{{.Link}}">View on LinkedIn</a></header>
+{{.HTML}}</article>
+{{end}}<footer>Exported by lnk</footer>
+<script type="application/json" id="` + dataScriptID + `">{{.Data}}</script>
+</body>
+</html>
+`))
+
+// digestEntry adds the trusted HTML content for rendering.
+type digestEntry struct {
+	Entry
+	HTML template.HTML
+}
+
+func writeHTML(w io.Writer, feed Feed, entries []Entry) error {
+	view := struct {
+		Feed    Feed
+		Entries []digestEntry
+		Data    []Entry
+	}{Feed: feed, Data: entries}
+	if view.Data == nil {
+		view.Data = []Entry{}
+	}
+	for _, e := range entries {
+		// Content is built by FromItem, which escapes all LinkedIn text and
+		// links only http and https URLs, or sanitized by Read.
+		view.Entries = append(view.Entries, digestEntry{Entry: e, HTML: template.HTML(e.Content)})
+	}
+
+	if err := digestTemplate.Execute(w, view); err != nil {
+		return fmt.Errorf("failed to write HTML digest: %w", err)
+	}
+	return nil
+}
+
+func readHTML(r io.Reader) ([]Entry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	open := []byte(`<script type="application/json" id="` + dataScriptID + `">`)
+	start := bytes.Index(data, open)
+	if start < 0 {
+		return nil, fmt.Errorf("not an lnk HTML digest")
+	}
+	data = data[start+len(open):]
+	end := bytes.Index(data, []byte("</script>"))
+	if end < 0 {
+		return nil, fmt.Errorf("not an lnk HTML digest")
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data[:end], &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse HTML digest: %w", err)
+	}
+	return entries, nil
+}
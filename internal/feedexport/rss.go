@@ -0,0 +1,113 @@
+package feedexport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const dcNS = "http://purl.org/dc/elements/1.1/"
+
+type rssDoc struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Creator     string  `xml:"dc:creator,omitempty"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// rssReadDoc mirrors rssDoc for decoding, where namespaced elements are
+// matched by local name.
+type rssReadDoc struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel struct {
+		Items []struct {
+			Title       string `xml:"title"`
+			Link        string `xml:"link"`
+			GUID        string `xml:"guid"`
+			PubDate     string `xml:"pubDate"`
+			Creator     string `xml:"creator"`
+			Description string `xml:"description"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func writeRSS(w io.Writer, feed Feed, entries []Entry) error {
+	doc := rssDoc{
+		Version: "2.0",
+		DC:      dcNS,
+		Channel: rssChannel{
+			Title:         feed.Title,
+			Link:          feed.Link,
+			Description:   feed.Title + " exported by lnk",
+			LastBuildDate: feed.Updated.UTC().Format(time.RFC1123Z),
+		},
+	}
+	for _, e := range entries {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       e.Title,
+			Link:        e.Link,
+			GUID:        rssGUID{IsPermaLink: "false", Value: e.ID},
+			PubDate:     entryTime(e, feed).Format(time.RFC1123Z),
+			Creator:     e.Author,
+			Description: e.Content,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write RSS feed: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readRSS(r io.Reader) ([]Entry, error) {
+	var doc rssReadDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(doc.Channel.Items))
+	for _, it := range doc.Channel.Items {
+		e := Entry{
+			ID:      it.GUID,
+			Title:   it.Title,
+			Link:    it.Link,
+			Author:  it.Creator,
+			Content: it.Description,
+		}
+		if e.ID == "" {
+			e.ID = it.Link
+		}
+		e.Published, _ = time.Parse(time.RFC1123Z, it.PubDate)
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
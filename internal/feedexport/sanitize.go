@@ -0,0 +1,109 @@
+package feedexport
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// safeURL returns u if it is an absolute http or https URL, and "" for
+// anything else, such as javascript: or data: URLs, which must never become
+// live links or images.
+func safeURL(u string) string {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return u
+	}
+	return ""
+}
+
+// allowedTags lists the elements FromItem emits and the attributes each
+// may carry. href and src must hold safe URLs.
+var allowedTags = map[string][]string{
+	"a":          {"href"},
+	"blockquote": nil,
+	"br":         nil,
+	"em":         nil,
+	"img":        {"src", "alt"},
+	"li":         nil,
+	"p":          nil,
+	"small":      nil,
+	"strong":     nil,
+	"ul":         nil,
+}
+
+var (
+	reTag  = regexp.MustCompile(`<(/?)([a-z]+)((?:\s+[a-z]+="[^"<>]*")*)\s*>`)
+	reAttr = regexp.MustCompile(`([a-z]+)="([^"<>]*)"`)
+)
+
+// sanitizeContent reduces entry content read back from an earlier export
+// to the HTML that FromItem produces: known tags with known attributes and
+// http or https URLs. Everything else is escaped as text, so a tampered
+// export cannot inject markup into the next digest.
+func sanitizeContent(s string) string {
+	var b strings.Builder
+	for s != "" {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		m := reTag.FindStringSubmatchIndex(s)
+		if m == nil || m[0] != 0 {
+			b.WriteString("&lt;")
+			s = s[1:]
+			continue
+		}
+		tag, ok := sanitizeTag(s[m[2]:m[3]] == "/", s[m[4]:m[5]], s[m[6]:m[7]])
+		if !ok {
+			b.WriteString("&lt;")
+			s = s[1:]
+			continue
+		}
+		b.WriteString(tag)
+		s = s[m[1]:]
+	}
+	return b.String()
+}
+
+// sanitizeTag rebuilds an allowed tag from its parts. It reports false for
+// tags and attributes FromItem never emits and for unsafe URLs.
+func sanitizeTag(closing bool, name, attrs string) (string, bool) {
+	allowed, ok := allowedTags[name]
+	if !ok {
+		return "", false
+	}
+	if closing {
+		if strings.TrimSpace(attrs) != "" {
+			return "", false
+		}
+		return "</" + name + ">", true
+	}
+
+	var b strings.Builder
+	b.WriteString("<" + name)
+	for _, a := range reAttr.FindAllStringSubmatch(attrs, -1) {
+		key, value := a[1], html.UnescapeString(a[2])
+		if !slices.Contains(allowed, key) {
+			return "", false
+		}
+		if key == "href" || key == "src" {
+			if value = safeURL(value); value == "" {
+				return "", false
+			}
+		}
+		b.WriteString(" " + key + `="` + html.EscapeString(value) + `"`)
+	}
+	b.WriteString(">")
+	return b.String(), true
+}
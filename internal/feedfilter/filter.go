@@ -0,0 +1,128 @@
+// Package feedfilter narrows and ranks feed items, from command-line
+// filters and from user-defined rule files.
+package feedfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+// Item kinds used by --only and rule types.
+const (
+	KindPost    = "post"
+	KindArticle = "article"
+	KindReshare = "reshare"
+)
+
+// Kind classifies a feed item: a reshare, a post sharing a link (article),
+// or an original post.
+func Kind(item *api.FeedItem) string {
+	switch {
+	case item.Type == api.FeedItemReshare:
+		return KindReshare
+	case item.Post != nil && item.Post.Article != nil:
+		return KindArticle
+	default:
+		return KindPost
+	}
+}
+
+// ParseKind accepts a kind in singular or plural form.
+func ParseKind(s string) (string, error) {
+	k := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "s")
+	switch k {
+	case KindPost, KindArticle, KindReshare:
+		return k, nil
+	}
+	return "", fmt.Errorf("invalid item type %q: use posts, articles or reshares", s)
+}
+
+// Filter drops feed items that do not match. Zero fields match everything.
+type Filter struct {
+	// Only keeps items of this kind.
+	Only string
+	// Author matches the actor's name (substring), URN or profile/company
+	// identifier.
+	Author string
+	// ExcludePromoted drops sponsored items.
+	ExcludePromoted bool
+	// MinReactions drops items with fewer reactions.
+	MinReactions int
+	// Match drops items whose text does not match.
+	Match *regexp.Regexp
+	// Since drops items created before this time. Items without a known
+	// time are kept.
+	Since time.Time
+}
+
+// Keep reports whether item passes the filter.
+func (f *Filter) Keep(item *api.FeedItem) bool {
+	switch {
+	case f.Only != "" && Kind(item) != f.Only:
+		return false
+	case f.Author != "" && !MatchAuthor(f.Author, item.Actor):
+		return false
+	case f.ExcludePromoted && item.Promoted:
+		return false
+	case f.MinReactions > 0 && (item.Post == nil || item.Post.LikeCount < f.MinReactions):
+		return false
+	case f.Match != nil && !f.Match.MatchString(Text(item)):
+		return false
+	case !f.Since.IsZero() && !item.CreatedAt.IsZero() && item.CreatedAt.Before(f.Since):
+		return false
+	}
+	return true
+}
+
+// Apply returns the items that pass the filter.
+func (f *Filter) Apply(items []api.FeedItem) []api.FeedItem {
+	var out []api.FeedItem
+	for i := range items {
+		if f.Keep(&items[i]) {
+			out = append(out, items[i])
+		}
+	}
+	return out
+}
+
+// MatchAuthor reports whether pattern identifies actor: a case-insensitive
+// substring of the name, the exact URN, or the public identifier in the
+// profile or company URL.
+func MatchAuthor(pattern string, actor *api.Actor) bool {
+	if actor == nil {
+		return false
+	}
+	p := strings.ToLower(strings.TrimSpace(pattern))
+	if p == "" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(actor.Name), p) || p == strings.ToLower(actor.URN) {
+		return true
+	}
+	u := strings.TrimSuffix(strings.ToLower(actor.URL), "/")
+	return u != "" && (u == strings.TrimSuffix(p, "/") ||
+		strings.HasSuffix(u, "/in/"+p) || strings.HasSuffix(u, "/company/"+p))
+}
+
+// Text returns the searchable text of an item: the commentary, link card,
+// poll question and the reshared post's text.
+func Text(item *api.FeedItem) string {
+	if item.Post == nil {
+		return ""
+	}
+	var parts []string
+	for p := item.Post; p != nil; p = p.Reshared {
+		parts = append(parts, p.Text)
+		if p.Article != nil {
+			parts = append(parts, p.Article.Title, p.Article.Description)
+		}
+		if p.Poll != nil {
+			parts = append(parts, p.Poll.Question)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
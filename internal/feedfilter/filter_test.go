@@ -0,0 +1,91 @@
+package feedfilter
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+func testItems() []api.FeedItem {
+	now := time.Now()
+	return []api.FeedItem{
+		{
+			URN: "a", Type: api.FeedItemPost, CreatedAt: now.Add(-time.Hour),
+			Actor: &api.Actor{Name: "Jane Doe", URN: "urn:li:member:1", URL: "https://www.linkedin.com/in/janedoe"},
+			Post:  &api.Post{Text: "We are hiring Go engineers", LikeCount: 50},
+		},
+		{
+			URN: "b", Type: api.FeedItemPost, CreatedAt: now.Add(-48 * time.Hour),
+			Actor: &api.Actor{Name: "Acme", Kind: api.ActorCompany, URL: "https://www.linkedin.com/company/acme"},
+			Post:  &api.Post{Text: "Read our blog", Article: &api.Article{Title: "Scaling Postgres"}, LikeCount: 3},
+		},
+		{
+			URN: "c", Type: api.FeedItemReshare, CreatedAt: now.Add(-2 * time.Hour),
+			Actor: &api.Actor{Name: "John Smith"},
+			Post:  &api.Post{Reshared: &api.Post{Text: "Original about Rust"}, LikeCount: 10},
+		},
+		{
+			URN: "d", Type: api.FeedItemPost, Promoted: true,
+			Actor: &api.Actor{Name: "Ads Inc"},
+			Post:  &api.Post{Text: "Buy now"},
+		},
+	}
+}
+
+func urns(items []api.FeedItem) string {
+	var s string
+	for _, it := range items {
+		s += it.URN
+	}
+	return s
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{name: "none", want: "abcd"},
+		{name: "only posts", filter: Filter{Only: KindPost}, want: "ad"},
+		{name: "only articles", filter: Filter{Only: KindArticle}, want: "b"},
+		{name: "only reshares", filter: Filter{Only: KindReshare}, want: "c"},
+		{name: "author name", filter: Filter{Author: "jane"}, want: "a"},
+		{name: "author public id", filter: Filter{Author: "acme"}, want: "b"},
+		{name: "author urn", filter: Filter{Author: "urn:li:member:1"}, want: "a"},
+		{name: "exclude promoted", filter: Filter{ExcludePromoted: true}, want: "abc"},
+		{name: "min reactions", filter: Filter{MinReactions: 10}, want: "ac"},
+		{name: "match reshared text", filter: Filter{Match: mustCompile(t, "rust|postgres")}, want: "bc"},
+		{name: "since keeps unknown time", filter: Filter{Since: time.Now().Add(-24 * time.Hour)}, want: "acd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := urns(tt.filter.Apply(testItems())); got != tt.want {
+				t.Errorf("Apply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	for in, want := range map[string]string{"posts": KindPost, "Article": KindArticle, "reshares": KindReshare} {
+		if got, err := ParseKind(in); err != nil || got != want {
+			t.Errorf("ParseKind(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseKind("videos"); err == nil {
+		t.Error("ParseKind(videos) expected error")
+	}
+}
+
+func mustCompile(t *testing.T, pattern string) *regexp.Regexp {
+	t.Helper()
+	re, err := CompileMatch(pattern)
+	if err != nil {
+		t.Fatalf("CompileMatch(%q) error: %v", pattern, err)
+	}
+	return re
+}
@@ -0,0 +1,186 @@
+package feedfilter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pp/lnk/internal/api"
+	"gopkg.in/yaml.v3"
+)
+
+// RulesDir is the config subdirectory holding rule files.
+const RulesDir = "feed-rules"
+
+// Rule actions.
+const (
+	ActionScore = "score"
+	ActionDrop  = "drop"
+)
+
+// Rule scores or drops the feed items it matches. All set conditions must
+// match; within a list any entry may match.
+type Rule struct {
+	Name string `yaml:"name"`
+	// Authors match as in MatchAuthor.
+	Authors []string `yaml:"authors"`
+	// Keywords match case-insensitively anywhere in the item text.
+	Keywords []string `yaml:"keywords"`
+	// Types are item kinds (post, article, reshare).
+	Types []string `yaml:"types"`
+	// Promoted, when set, matches only promoted (true) or organic (false)
+	// items.
+	Promoted *bool `yaml:"promoted"`
+	// Action is "score" (default) or "drop".
+	Action string `yaml:"action"`
+	// Score is added to matching items' score.
+	Score int `yaml:"score"`
+}
+
+// Matches reports whether the rule applies to item.
+func (r *Rule) Matches(item *api.FeedItem) bool {
+	if len(r.Authors) > 0 && !anyMatch(r.Authors, func(a string) bool { return MatchAuthor(a, item.Actor) }) {
+		return false
+	}
+	if len(r.Keywords) > 0 {
+		text := strings.ToLower(Text(item))
+		if !anyMatch(r.Keywords, func(k string) bool { return strings.Contains(text, strings.ToLower(k)) }) {
+			return false
+		}
+	}
+	if len(r.Types) > 0 {
+		kind := Kind(item)
+		if !anyMatch(r.Types, func(t string) bool { k, _ := ParseKind(t); return k == kind }) {
+			return false
+		}
+	}
+	if r.Promoted != nil && *r.Promoted != item.Promoted {
+		return false
+	}
+	return true
+}
+
+// validate checks a rule's action and types.
+func (r *Rule) validate() error {
+	switch strings.ToLower(r.Action) {
+	case "", ActionScore, ActionDrop:
+	default:
+		return fmt.Errorf("rule %q: invalid action %q (use score or drop)", r.Name, r.Action)
+	}
+	for _, t := range r.Types {
+		if _, err := ParseKind(t); err != nil {
+			return fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// Rules is an ordered rule set.
+type Rules []Rule
+
+// ruleFile is the YAML layout of a rule file.
+type ruleFile struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Parse decodes a YAML rule file.
+func Parse(data []byte) (Rules, error) {
+	var f ruleFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	for i := range f.Rules {
+		if err := f.Rules[i].validate(); err != nil {
+			return nil, err
+		}
+	}
+	return f.Rules, nil
+}
+
+// Load reads every .yaml and .yml file in dir, in name order. A missing
+// directory means no rules.
+func Load(dir string) (Rules, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read feed rules: %w", err)
+	}
+
+	var rules Rules
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read feed rules: %w", err)
+		}
+		parsed, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		rules = append(rules, parsed...)
+	}
+	return rules, nil
+}
+
+// Apply drops items matched by a drop rule, sets the score of the rest from
+// the score rules they match, and orders them by score. Items with equal
+// scores keep their feed order.
+func (rs Rules) Apply(items []api.FeedItem) []api.FeedItem {
+	var out []api.FeedItem
+	for _, item := range items {
+		if rs.Keep(&item) {
+			out = append(out, item)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Score > out[j].Score
+	})
+	return out
+}
+
+// Keep adds the scores of matching rules to item and reports whether it
+// survives the drop rules. It does not reorder, so it suits streaming
+// output.
+func (rs Rules) Keep(item *api.FeedItem) bool {
+	for i := range rs {
+		if !rs[i].Matches(item) {
+			continue
+		}
+		if strings.EqualFold(rs[i].Action, ActionDrop) {
+			return false
+		}
+		item.Score += rs[i].Score
+	}
+	return true
+}
+
+// anyMatch reports whether fn holds for any element of list.
+func anyMatch(list []string, fn func(string) bool) bool {
+	for _, s := range list {
+		if fn(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileMatch compiles a --match pattern case-insensitively unless it
+// sets its own flags.
+func CompileMatch(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pattern, "(?") {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.Compile(pattern)
+}
@@ -0,0 +1,86 @@
+package feedfilter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testRules = `
+rules:
+  - name: hide ads
+    promoted: true
+    action: drop
+  - name: boost Jane
+    authors: [jane]
+    score: 5
+  - name: boost databases
+    keywords: [postgres, rust]
+    score: 10
+  - name: fewer reshares
+    types: [reshares]
+    score: -3
+`
+
+func TestRulesApply(t *testing.T) {
+	rules, err := Parse([]byte(testRules))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(rules) != 4 {
+		t.Fatalf("got %d rules, want 4", len(rules))
+	}
+
+	got := rules.Apply(testItems())
+	if urns(got) != "bca" {
+		t.Fatalf("Apply() order = %q, want %q", urns(got), "bca")
+	}
+	scores := map[string]int{}
+	for _, it := range got {
+		scores[it.URN] = it.Score
+	}
+	if scores["a"] != 5 || scores["b"] != 10 || scores["c"] != 7 {
+		t.Errorf("scores = %v", scores)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{
+		"rules:\n  - action: hide\n",
+		"rules:\n  - types: [videos]\n",
+		"rules: [",
+	} {
+		if _, err := Parse([]byte(input)); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	rules, err := Load(filepath.Join(dir, "missing"))
+	if err != nil || len(rules) != 0 {
+		t.Fatalf("Load(missing) = %v, %v", rules, err)
+	}
+
+	files := map[string]string{
+		"01-drop.yaml":  "rules:\n  - name: a\n    action: drop\n    types: [article]\n",
+		"02-score.yml":  "rules:\n  - name: b\n    score: 1\n",
+		"notes.txt":     "not rules",
+		"03-empty.yaml": "",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rules, err = Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(rules) != 2 || rules[0].Name != "a" || rules[1].Name != "b" {
+		t.Errorf("Load() = %+v", rules)
+	}
+}
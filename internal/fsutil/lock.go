@@ -0,0 +1,72 @@
+// Package fsutil provides helpers for the files lnk keeps in its config
+// directory.
+package fsutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrLocked indicates another process holds the lock.
+var ErrLocked = errors.New("lock is held by another process")
+
+// lockPollInterval is how often WaitLock retries a held lock.
+const lockPollInterval = 20 * time.Millisecond
+
+// Lock is an exclusive, file-based lock.
+type Lock struct {
+	path string
+}
+
+// TryLock creates the lock file at path, returning ErrLocked if another
+// process holds it. Locks older than staleAge are assumed to belong to a
+// crashed process and are broken.
+func TryLock(path string, staleAge time.Duration) (*Lock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_, _ = fmt.Fprintf(f, "%d %s\n", os.Getpid(), time.Now().Format(time.RFC3339))
+			_ = f.Close()
+			return &Lock{path: path}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock: %w", err)
+		}
+
+		// Break the lock if its holder appears to have died.
+		info, statErr := os.Stat(path)
+		if statErr != nil || time.Since(info.ModTime()) < staleAge {
+			return nil, ErrLocked
+		}
+		_ = os.Remove(path)
+	}
+
+	return nil, ErrLocked
+}
+
+// WaitLock is like TryLock but keeps retrying a held lock until timeout.
+func WaitLock(path string, staleAge, timeout time.Duration) (*Lock, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		lock, err := TryLock(path, staleAge)
+		if !errors.Is(err, ErrLocked) || time.Now().After(deadline) {
+			return lock, err
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// Release removes the lock file.
+func (l *Lock) Release() error {
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
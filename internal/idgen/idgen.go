@@ -0,0 +1,19 @@
+// Package idgen generates the short IDs of locally stored records, such as
+// scheduled posts, drafts and audit entries.
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// New returns a short random identifier of eight hex digits.
+func New() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%08x", time.Now().UnixNano()&0xffffffff)
+	}
+	return hex.EncodeToString(b)
+}
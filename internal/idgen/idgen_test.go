@@ -0,0 +1,17 @@
+package idgen
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	re := regexp.MustCompile(`^[0-9a-f]{8}$`)
+	a, b := New(), New()
+	if !re.MatchString(a) || !re.MatchString(b) {
+		t.Errorf("New() = %q, %q, want eight hex digits", a, b)
+	}
+	if a == b {
+		t.Errorf("New() returned %q twice", a)
+	}
+}
@@ -0,0 +1,326 @@
+// Package markdown converts Markdown into the plain-text dialect that
+// LinkedIn renders: Unicode styled letters instead of emphasis markers,
+// bullet glyphs instead of list markers, and bare URLs instead of links.
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// MaxPostLength is LinkedIn's limit for post text, in UTF-16 code units.
+const MaxPostLength = 3000
+
+// style selects a Unicode mathematical alphanumeric alphabet.
+type style int
+
+const (
+	styleBold style = iota
+	styleItalic
+	styleBoldItalic
+	styleMonospace
+)
+
+// alphabet holds the first code point for A, a and 0 in a styled alphabet.
+// A zero digit base leaves digits unstyled.
+type alphabet struct {
+	upper, lower, digit rune
+}
+
+var alphabets = map[style]alphabet{
+	styleBold:       {upper: 0x1D5D4, lower: 0x1D5EE, digit: 0x1D7EC},
+	styleItalic:     {upper: 0x1D608, lower: 0x1D622},
+	styleBoldItalic: {upper: 0x1D63C, lower: 0x1D656, digit: 0x1D7EC},
+	styleMonospace:  {upper: 0x1D670, lower: 0x1D68A, digit: 0x1D7F6},
+}
+
+// stylize maps ASCII letters and digits in s to the given style.
+func stylize(s string, st style) string {
+	a := alphabets[st]
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			b.WriteRune(a.upper + r - 'A')
+		case r >= 'a' && r <= 'z':
+			b.WriteRune(a.lower + r - 'a')
+		case r >= '0' && r <= '9' && a.digit != 0:
+			b.WriteRune(a.digit + r - '0')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// strike overlays a combining long stroke on every character.
+func strike(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteRune(r)
+		if r != ' ' {
+			b.WriteRune('\u0336')
+		}
+	}
+	return b.String()
+}
+
+// Block-level patterns.
+var (
+	reFence      = regexp.MustCompile("^\\s*(```|~~~)")
+	reHeading    = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)\s*#*\s*$`)
+	reRule       = regexp.MustCompile(`^\s{0,3}(?:-(?:\s*-){2,}|\*(?:\s*\*){2,}|_(?:\s*_){2,})\s*$`)
+	reTask       = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	reBullet     = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	reOrdered    = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	reBlockquote = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+)
+
+// Inline patterns, applied in order.
+var (
+	reEscape     = regexp.MustCompile(`\\([\\` + "`" + `*_{}\[\]()#+\-.!~>])`)
+	reCode       = regexp.MustCompile("`([^`]+)`")
+	reImage      = regexp.MustCompile(`!\[([^\]]*)\]\(\s*<?([^\s)>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	reLink       = regexp.MustCompile(`\[([^\]]+)\]\(\s*<?([^\s)>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	reAutolink   = regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`)
+	reBareURL    = regexp.MustCompile(`https?://[^\s<>]+`)
+	reBoldItalic = regexp.MustCompile(`\*\*\*(\S(?:.*?\S)?)\*\*\*|___(\S(?:.*?\S)?)___`)
+	reBold       = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	reItalicStar = regexp.MustCompile(`\*(\S(?:.*?\S)?)\*`)
+	reItalicUnd  = regexp.MustCompile(`(^|[^\p{L}\p{N}_])_(\S(?:.*?\S)?)_($|[^\p{L}\p{N}_])`)
+	reStrike     = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+)
+
+// Convert renders Markdown as LinkedIn-ready plain text.
+func Convert(md string) string {
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	out := make([]string, 0, len(lines))
+
+	inFence := false
+	for _, line := range lines {
+		if reFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+		out = append(out, convertLine(line))
+	}
+
+	return strings.TrimSpace(collapseBlankLines(strings.Join(out, "\n")))
+}
+
+// convertLine converts a single non-code Markdown line.
+func convertLine(line string) string {
+	if m := reHeading.FindStringSubmatch(line); m != nil {
+		// Style the heading before restoring protected text so code and
+		// URLs inside it keep their own form.
+		var p placeholders
+		return p.restore(stylize(emphasize(p.protectAll(m[1])), styleBold))
+	}
+	if reRule.MatchString(line) {
+		return "――――――――――"
+	}
+	if m := reTask.FindStringSubmatch(line); m != nil {
+		box := "☐"
+		if m[2] != " " {
+			box = "☑"
+		}
+		return listIndent(m[1]) + box + " " + convertInline(m[3])
+	}
+	if m := reBullet.FindStringSubmatch(line); m != nil {
+		indent := listIndent(m[1])
+		glyph := "•"
+		if indent != "" {
+			glyph = "◦"
+		}
+		return indent + glyph + " " + convertInline(m[2])
+	}
+	if m := reOrdered.FindStringSubmatch(line); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		return fmt.Sprintf("%s%d. %s", listIndent(m[1]), n, convertInline(m[3]))
+	}
+	if m := reBlockquote.FindStringSubmatch(line); m != nil {
+		return "│ " + convertInline(m[1])
+	}
+	return convertInline(strings.TrimRight(line, " "))
+}
+
+// listIndent maps Markdown list indentation to nesting spaces.
+func listIndent(ws string) string {
+	width := len(strings.ReplaceAll(ws, "\t", "    "))
+	return strings.Repeat("   ", width/2)
+}
+
+// convertInline converts emphasis, code and links within a line.
+func convertInline(s string) string {
+	var p placeholders
+	return p.restore(emphasize(p.protectAll(s)))
+}
+
+// placeholderBase is the first rune used for placeholders. It is in a
+// supplementary private use area, which neither the emphasis patterns nor
+// stylize treat as letters, so placeholders survive styling intact.
+const placeholderBase rune = 0xF0000
+
+// placeholders holds text that must not be styled (code, URLs, escapes)
+// while emphasis is converted around it.
+type placeholders []string
+
+// protect stores v and returns the placeholder that stands for it. Any
+// placeholders inside v are expanded first, so stored text never needs
+// expanding again.
+func (p *placeholders) protect(v string) string {
+	*p = append(*p, p.restore(v))
+	return string(placeholderBase + rune(len(*p)-1))
+}
+
+// protectAll replaces escapes, code spans, images, links and URLs in s with
+// placeholders.
+func (p *placeholders) protectAll(s string) string {
+	// Runes from placeholderBase on are private use and would be taken for
+	// placeholders, so any the input already contains are protected first.
+	if strings.ContainsFunc(s, isPlaceholderRange) {
+		var b strings.Builder
+		for _, r := range s {
+			if isPlaceholderRange(r) {
+				// Stored as is: r is not a placeholder to expand.
+				*p = append(*p, string(r))
+				b.WriteRune(placeholderBase + rune(len(*p)-1))
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		s = b.String()
+	}
+
+	s = reEscape.ReplaceAllStringFunc(s, func(m string) string {
+		return p.protect(m[1:])
+	})
+	s = reCode.ReplaceAllStringFunc(s, func(m string) string {
+		return p.protect(stylize(reCode.FindStringSubmatch(m)[1], styleMonospace))
+	})
+	s = reImage.ReplaceAllStringFunc(s, func(m string) string {
+		return p.protect(reImage.FindStringSubmatch(m)[2])
+	})
+	s = reLink.ReplaceAllStringFunc(s, func(m string) string {
+		sub := reLink.FindStringSubmatch(m)
+		text, target := sub[1], strings.TrimPrefix(sub[2], "mailto:")
+		if text == sub[2] || text == target {
+			return p.protect(target)
+		}
+		return text + " " + p.protect("("+target+")")
+	})
+	s = reAutolink.ReplaceAllStringFunc(s, func(m string) string {
+		return p.protect(strings.TrimPrefix(reAutolink.FindStringSubmatch(m)[1], "mailto:"))
+	})
+	return reBareURL.ReplaceAllStringFunc(s, func(m string) string {
+		url := trimURL(m)
+		return p.protect(url) + m[len(url):]
+	})
+}
+
+// restore replaces placeholders in s with the text they protect, in a
+// single pass: protect has already expanded placeholders nested in the
+// protected text, and the text it inserts is not scanned again.
+func (p placeholders) restore(s string) string {
+	if !strings.ContainsFunc(s, p.isPlaceholder) {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		if p.isPlaceholder(r) {
+			b.WriteString(p[r-placeholderBase])
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isPlaceholder reports whether r stands for protected text.
+func (p placeholders) isPlaceholder(r rune) bool {
+	return r >= placeholderBase && r < placeholderBase+rune(len(p))
+}
+
+// isPlaceholderRange reports whether r could be taken for a placeholder.
+func isPlaceholderRange(r rune) bool {
+	return r >= placeholderBase
+}
+
+// trimURL strips trailing characters that end the surrounding sentence or
+// Markdown markup rather than the URL: emphasis markers, punctuation and
+// closing parentheses without a matching opening one.
+func trimURL(url string) string {
+	for url != "" {
+		last := url[len(url)-1]
+		if last == ')' && strings.Count(url, "(") >= strings.Count(url, ")") {
+			break
+		}
+		if !strings.ContainsRune("*_~).,;:!?'\"", rune(last)) {
+			break
+		}
+		url = url[:len(url)-1]
+	}
+	return url
+}
+
+// emphasize converts bold, italic and strikethrough markers.
+func emphasize(s string) string {
+	s = reBoldItalic.ReplaceAllStringFunc(s, func(m string) string {
+		return stylize(firstGroup(reBoldItalic.FindStringSubmatch(m)), styleBoldItalic)
+	})
+	s = reBold.ReplaceAllStringFunc(s, func(m string) string {
+		return stylize(firstGroup(reBold.FindStringSubmatch(m)), styleBold)
+	})
+	s = reItalicStar.ReplaceAllStringFunc(s, func(m string) string {
+		return stylize(reItalicStar.FindStringSubmatch(m)[1], styleItalic)
+	})
+	s = reItalicUnd.ReplaceAllStringFunc(s, func(m string) string {
+		sub := reItalicUnd.FindStringSubmatch(m)
+		return sub[1] + stylize(sub[2], styleItalic) + sub[3]
+	})
+	return reStrike.ReplaceAllStringFunc(s, func(m string) string {
+		return strike(reStrike.FindStringSubmatch(m)[1])
+	})
+}
+
+// firstGroup returns the first non-empty capture group of a match.
+func firstGroup(sub []string) string {
+	for _, g := range sub[1:] {
+		if g != "" {
+			return g
+		}
+	}
+	return ""
+}
+
+// collapseBlankLines limits runs of blank lines to one.
+func collapseBlankLines(s string) string {
+	for strings.Contains(s, "\n\n\n") {
+		s = strings.ReplaceAll(s, "\n\n\n", "\n\n")
+	}
+	return s
+}
+
+// Length returns the length of text as LinkedIn counts it (UTF-16 code
+// units). Styled letters outside the Basic Multilingual Plane count twice.
+func Length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
+
+// Warnings returns human-readable problems with post text, such as
+// exceeding LinkedIn's length limit.
+func Warnings(text string) []string {
+	var warnings []string
+	if n := Length(text); n > MaxPostLength {
+		warnings = append(warnings, fmt.Sprintf(
+			"post is %d characters; LinkedIn allows %d (styled letters count as 2)", n, MaxPostLength))
+	}
+	return warnings
+}
@@ -0,0 +1,180 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "plain text",
+			input: "Hello LinkedIn!",
+			want:  "Hello LinkedIn!",
+		},
+		{
+			name:  "bold",
+			input: "**Big** news",
+			want:  "𝗕𝗶𝗴 news",
+		},
+		{
+			name:  "italic",
+			input: "*so* _very_ good",
+			want:  "𝘴𝘰 𝘷𝘦𝘳𝘺 good",
+		},
+		{
+			name:  "snake case is not italic",
+			input: "use snake_case_names",
+			want:  "use snake_case_names",
+		},
+		{
+			name:  "bullets",
+			input: "- one\n* two\n  - nested",
+			want:  "• one\n• two\n   ◦ nested",
+		},
+		{
+			name:  "ordered list",
+			input: "1. first\n2) second",
+			want:  "1. first\n2. second",
+		},
+		{
+			name:  "link",
+			input: "Read [the docs](https://example.com/docs) now",
+			want:  "Read the docs (https://example.com/docs) now",
+		},
+		{
+			name:  "link text is the url",
+			input: "[https://example.com](https://example.com)",
+			want:  "https://example.com",
+		},
+		{
+			name:  "autolink",
+			input: "See <https://example.com/a_b_c>",
+			want:  "See https://example.com/a_b_c",
+		},
+		{
+			name:  "bare url keeps underscores",
+			input: "https://example.com/_x_ and _y_",
+			want:  "https://example.com/_x_ and 𝘺",
+		},
+		{
+			name:  "code inside bold",
+			input: "**use `code` now**",
+			want:  "𝘂𝘀𝗲 𝚌𝚘𝚍𝚎 𝗻𝗼𝘄",
+		},
+		{
+			name:  "digits in code inside bold",
+			input: "**v2 `x1`**",
+			want:  "𝘃𝟮 𝚡𝟷",
+		},
+		{
+			name:  "link inside bold",
+			input: "**see [docs](https://a.b/c)**",
+			want:  "𝘀𝗲𝗲 𝗱𝗼𝗰𝘀 (https://a.b/c)",
+		},
+		{
+			name:  "bare url inside bold",
+			input: "**see https://x.com**",
+			want:  "𝘀𝗲𝗲 https://x.com",
+		},
+		{
+			name:  "bare url inside italic",
+			input: "_see https://x.com/a_b_",
+			want:  "𝘴𝘦𝘦 https://x.com/a_b",
+		},
+		{
+			name:  "bare url before punctuation",
+			input: "(see https://x.com). Or https://en.wikipedia.org/wiki/Go_(language)!",
+			want:  "(see https://x.com). Or https://en.wikipedia.org/wiki/Go_(language)!",
+		},
+		{
+			name:  "code and url inside heading",
+			input: "# Go `v2` at https://go.dev/x",
+			want:  "𝗚𝗼 𝚟𝟸 𝗮𝘁 https://go.dev/x",
+		},
+		{
+			name:  "link inside heading",
+			input: "## Read [docs](https://a.b/c)",
+			want:  "𝗥𝗲𝗮𝗱 𝗱𝗼𝗰𝘀 (https://a.b/c)",
+		},
+		{
+			name:  "heading",
+			input: "# Title\n\nBody",
+			want:  "𝗧𝗶𝘁𝗹𝗲\n\nBody",
+		},
+		{
+			name:  "escaped markers",
+			input: `\*not italic\*`,
+			want:  "*not italic*",
+		},
+		{
+			name:  "code fence is verbatim",
+			input: "```\n**x**\n```",
+			want:  "**x**",
+		},
+		{
+			name:  "blank lines collapse",
+			input: "a\n\n\n\nb",
+			want:  "a\n\nb",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Convert(tt.input); got != tt.want {
+				t.Errorf("Convert(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertPrivateUseRunes(t *testing.T) {
+	// Runes in the placeholder range must come out as they went in, not as
+	// protected text, and must never make the conversion loop.
+	tests := []struct {
+		input, want string
+	}{
+		{"`\U000F0000`", "\U000F0000"},
+		{"\U000F0000 **bold** `code`", "\U000F0000 𝗯𝗼𝗹𝗱 𝚌𝚘𝚍𝚎"},
+		{"`a` \U000F0000\U000F0001 https://a.b/\U000F0000", "𝚊 \U000F0000\U000F0001 https://a.b/\U000F0000"},
+		{"# \U000F0001 `x`", "\U000F0001 𝚡"},
+		{"[\U000F0000](https://a.b/c)", "\U000F0000 (https://a.b/c)"},
+		{"\U0010FFFD `x`", "\U0010FFFD 𝚡"},
+	}
+
+	for _, tt := range tests {
+		done := make(chan string, 1)
+		go func() { done <- Convert(tt.input) }()
+		select {
+		case got := <-done:
+			if got != tt.want {
+				t.Errorf("Convert(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Convert(%q) did not return", tt.input)
+		}
+	}
+}
+
+func TestLength(t *testing.T) {
+	if got := Length("abc"); got != 3 {
+		t.Errorf("Length(abc) = %d, want 3", got)
+	}
+	if got := Length(stylize("abc", styleBold)); got != 6 {
+		t.Errorf("Length(bold abc) = %d, want 6", got)
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	if w := Warnings(strings.Repeat("a", MaxPostLength)); len(w) != 0 {
+		t.Errorf("Warnings at limit = %v, want none", w)
+	}
+	if w := Warnings(strings.Repeat("a", MaxPostLength+1)); len(w) != 1 {
+		t.Errorf("Warnings over limit = %v, want one", w)
+	}
+}
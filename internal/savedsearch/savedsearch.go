@@ -0,0 +1,264 @@
+// Package savedsearch stores named searches and the results of their
+// recent runs, so that new and vanished results can be reported.
+package savedsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/fsutil"
+)
+
+// File is the filename of the saved search store.
+const File = "searches.json"
+
+// LockFile is the filename used to serialize store updates.
+const LockFile = "searches.json.lock"
+
+const (
+	// lockAge is how old a lock must be before it is considered abandoned.
+	lockAge = time.Minute
+	// lockWait is how long updates wait for the lock.
+	lockWait = 10 * time.Second
+)
+
+// MaxRuns is how many runs are kept per search.
+const MaxRuns = 10
+
+// Search types.
+const (
+	TypePeople    = "people"
+	TypeCompanies = "companies"
+	TypeJobs      = "jobs"
+	TypePosts     = "posts"
+)
+
+// ErrNotFound indicates no saved search has the given name.
+var ErrNotFound = errors.New("saved search not found")
+
+var reName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Search is a named search and its recent runs, oldest first. Only the
+// options for its Type are set.
+type Search struct {
+	Name      string                 `json:"name"`
+	Type      string                 `json:"type"`
+	Query     string                 `json:"query"`
+	Limit     int                    `json:"limit,omitempty"`
+	People    *api.PeopleFilters     `json:"people,omitempty"`
+	Jobs      *api.JobSearchOptions  `json:"jobs,omitempty"`
+	Posts     *api.PostSearchOptions `json:"posts,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Runs      []Run                  `json:"runs,omitempty"`
+}
+
+// LastRun returns the most recent run, or nil if the search never ran.
+func (s *Search) LastRun() *Run {
+	if len(s.Runs) == 0 {
+		return nil
+	}
+	return &s.Runs[len(s.Runs)-1]
+}
+
+// Run is the result set of one execution of a search.
+type Run struct {
+	Time    time.Time `json:"time"`
+	Results []Result  `json:"results"`
+}
+
+// Result is a single search result. Data holds the full API object.
+type Result struct {
+	URN      string          `json:"urn"`
+	Title    string          `json:"title"`
+	Subtitle string          `json:"subtitle,omitempty"`
+	URL      string          `json:"url,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// ValidateName checks that name can identify a saved search.
+func ValidateName(name string) error {
+	if !reName.MatchString(name) {
+		return fmt.Errorf("invalid search name %q: use letters, digits, '.', '_' and '-'", name)
+	}
+	return nil
+}
+
+// ValidateType checks that t is a search type that can be saved.
+func ValidateType(t string) error {
+	switch t {
+	case TypePeople, TypeCompanies, TypeJobs, TypePosts:
+		return nil
+	default:
+		return fmt.Errorf("invalid search type %q: use %s, %s, %s or %s", t, TypePeople, TypeCompanies, TypeJobs, TypePosts)
+	}
+}
+
+// Diff compares two result sets by URN and returns the results that
+// appeared in cur and those that disappeared from prev, in their original
+// order.
+func Diff(prev, cur []Result) (added, removed []Result) {
+	before := make(map[string]bool, len(prev))
+	for _, r := range prev {
+		before[r.URN] = true
+	}
+	after := make(map[string]bool, len(cur))
+	for _, r := range cur {
+		after[r.URN] = true
+		if !before[r.URN] {
+			added = append(added, r)
+		}
+	}
+	for _, r := range prev {
+		if !after[r.URN] {
+			removed = append(removed, r)
+		}
+	}
+	return added, removed
+}
+
+// Store manages the saved searches file.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Path returns the store file path.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, File)
+}
+
+// List returns all saved searches ordered by name.
+func (s *Store) List() ([]Search, error) {
+	data, err := os.ReadFile(s.Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Search{}, nil
+		}
+		return nil, fmt.Errorf("failed to read saved searches: %w", err)
+	}
+
+	var searches []Search
+	if err := json.Unmarshal(data, &searches); err != nil {
+		return nil, fmt.Errorf("failed to parse saved searches: %w", err)
+	}
+	sort.Slice(searches, func(i, j int) bool { return searches[i].Name < searches[j].Name })
+	return searches, nil
+}
+
+// Get returns the saved search with the given name.
+func (s *Store) Get(name string) (*Search, error) {
+	searches, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range searches {
+		if searches[i].Name == name {
+			return &searches[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Put adds search, replacing any saved search with the same name.
+func (s *Store) Put(search *Search) error {
+	if err := ValidateName(search.Name); err != nil {
+		return err
+	}
+	if err := ValidateType(search.Type); err != nil {
+		return err
+	}
+
+	return s.update(func(searches []Search) ([]Search, error) {
+		for i := range searches {
+			if searches[i].Name == search.Name {
+				searches[i] = *search
+				return searches, nil
+			}
+		}
+		return append(searches, *search), nil
+	})
+}
+
+// Delete removes the saved search with the given name.
+func (s *Store) Delete(name string) error {
+	return s.update(func(searches []Search) ([]Search, error) {
+		for i := range searches {
+			if searches[i].Name == name {
+				return append(searches[:i], searches[i+1:]...), nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	})
+}
+
+// Record appends a run to the named search, keeping the last MaxRuns, and
+// returns the run before it, or nil if this is the first.
+func (s *Store) Record(name string, run Run) (*Run, error) {
+	var prev *Run
+	err := s.update(func(searches []Search) ([]Search, error) {
+		for i := range searches {
+			if searches[i].Name != name {
+				continue
+			}
+			if last := searches[i].LastRun(); last != nil {
+				p := *last
+				prev = &p
+			}
+			runs := append(searches[i].Runs, run)
+			if len(runs) > MaxRuns {
+				runs = runs[len(runs)-MaxRuns:]
+			}
+			searches[i].Runs = runs
+			return searches, nil
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prev, nil
+}
+
+// update applies fn to the current searches under the store lock and saves
+// the result, so overlapping commands never overwrite each other.
+func (s *Store) update(fn func(searches []Search) ([]Search, error)) error {
+	lock, err := fsutil.WaitLock(filepath.Join(s.dir, LockFile), lockAge, lockWait)
+	if err != nil {
+		return fmt.Errorf("failed to lock saved searches: %w", err)
+	}
+	defer func() { _ = lock.Release() }()
+
+	searches, err := s.List()
+	if err != nil {
+		return err
+	}
+	searches, err = fn(searches)
+	if err != nil {
+		return err
+	}
+	return s.save(searches)
+}
+
+// save writes all searches to disk atomically.
+func (s *Store) save(searches []Search) error {
+	data, err := json.MarshalIndent(searches, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal saved searches: %w", err)
+	}
+
+	if err := fsutil.WriteFileAtomic(s.Path(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write saved searches: %w", err)
+	}
+	return nil
+}
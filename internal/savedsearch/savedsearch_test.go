@@ -0,0 +1,162 @@
+package savedsearch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+func TestStorePutGetDelete(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	search := &Search{
+		Name:   "ios",
+		Type:   TypePeople,
+		Query:  "ios engineer",
+		People: &api.PeopleFilters{Locations: []string{"Berlin"}},
+	}
+	if err := store.Put(search); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(&Search{Name: "backend", Type: TypeJobs, Query: "go"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, err := store.Get("ios")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Query != "ios engineer" || got.People == nil || got.People.Locations[0] != "Berlin" {
+		t.Errorf("Get = %+v", got)
+	}
+
+	list, _ := store.List()
+	if len(list) != 2 || list[0].Name != "backend" {
+		t.Errorf("List = %+v, want sorted by name", list)
+	}
+
+	// Put replaces a search with the same name.
+	search.Query = "swift engineer"
+	if err := store.Put(search); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := store.List(); len(list) != 2 {
+		t.Errorf("Put duplicated search: %d entries", len(list))
+	}
+
+	if err := store.Delete("ios"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Get("ios"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete error = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete("ios"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete missing error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStoreRejectsInvalid(t *testing.T) {
+	store := NewStore(t.TempDir())
+	if err := store.Put(&Search{Name: "bad name", Type: TypePeople}); err == nil {
+		t.Error("expected error for name with space")
+	}
+	if err := store.Put(&Search{Name: "ok", Type: "groups"}); err == nil {
+		t.Error("expected error for unknown type")
+	}
+}
+
+func TestStoreRecord(t *testing.T) {
+	store := NewStore(t.TempDir())
+	if err := store.Put(&Search{Name: "s", Type: TypePeople, Query: "q"}); err != nil {
+		t.Fatal(err)
+	}
+
+	prev, err := store.Record("s", Run{Time: time.Now(), Results: []Result{{URN: "a"}}})
+	if err != nil || prev != nil {
+		t.Fatalf("first Record = %v, %v; want nil, nil", prev, err)
+	}
+	prev, err = store.Record("s", Run{Time: time.Now(), Results: []Result{{URN: "b"}}})
+	if err != nil || prev == nil || prev.Results[0].URN != "a" {
+		t.Fatalf("second Record = %+v, %v; want previous run a", prev, err)
+	}
+
+	for i := 0; i < MaxRuns+5; i++ {
+		if _, err := store.Record("s", Run{Results: []Result{{URN: fmt.Sprint(i)}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, _ := store.Get("s")
+	if len(s.Runs) != MaxRuns {
+		t.Errorf("kept %d runs, want %d", len(s.Runs), MaxRuns)
+	}
+	if last := s.LastRun(); last.Results[0].URN != fmt.Sprint(MaxRuns+4) {
+		t.Errorf("LastRun = %+v", last)
+	}
+
+	if _, err := store.Record("missing", Run{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Record missing error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStoreConcurrentUpdates(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- store.Put(&Search{Name: fmt.Sprintf("s%d", i), Type: TypePeople, Query: "q"})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Put() error: %v", err)
+		}
+	}
+
+	searches, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(searches) != n {
+		t.Errorf("len(searches) = %d, want %d", len(searches), n)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev := []Result{{URN: "a"}, {URN: "b"}, {URN: "c"}}
+	cur := []Result{{URN: "c"}, {URN: "d"}, {URN: "a"}, {URN: "e"}}
+
+	added, removed := Diff(prev, cur)
+	if urns(added) != "d,e" {
+		t.Errorf("added = %s, want d,e", urns(added))
+	}
+	if urns(removed) != "b" {
+		t.Errorf("removed = %s, want b", urns(removed))
+	}
+
+	added, removed = Diff(nil, prev)
+	if urns(added) != "a,b,c" || len(removed) != 0 {
+		t.Errorf("first diff = %s / %s", urns(added), urns(removed))
+	}
+}
+
+func urns(results []Result) string {
+	var s string
+	for i, r := range results {
+		if i > 0 {
+			s += ","
+		}
+		s += r.URN
+	}
+	return s
+}
@@ -0,0 +1,43 @@
+package schedule
+
+import (
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/pp/lnk/internal/fsutil"
+)
+
+const (
+	// StaleLockAge is how old a runner lock must be before it is considered
+	// abandoned by a crashed runner.
+	StaleLockAge = 15 * time.Minute
+
+	// queueLockAge is the stale age for the short-lived queue lock.
+	queueLockAge = time.Minute
+	// queueLockWait is how long queue updates wait for the queue lock.
+	queueLockWait = 10 * time.Second
+)
+
+// ErrLocked indicates another runner currently holds the schedule lock.
+var ErrLocked = errors.New("another schedule runner is active")
+
+// Lock is an exclusive, file-based lock on the schedule.
+type Lock = fsutil.Lock
+
+// AcquireLock takes the runner lock in dir, returning ErrLocked if another
+// runner holds it. Locks older than StaleLockAge are broken.
+func AcquireLock(dir string) (*Lock, error) {
+	lock, err := fsutil.TryLock(filepath.Join(dir, LockFile), StaleLockAge)
+	if errors.Is(err, fsutil.ErrLocked) {
+		return nil, ErrLocked
+	}
+	return lock, err
+}
+
+// lockQueue takes the queue lock in dir, which guards every
+// load-modify-save of the queue file. It is held only for the duration of a
+// single update, never across a publish.
+func lockQueue(dir string) (*Lock, error) {
+	return fsutil.WaitLock(filepath.Join(dir, QueueLockFile), queueLockAge, queueLockWait)
+}
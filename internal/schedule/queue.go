@@ -0,0 +1,271 @@
+// Package schedule provides a local queue of posts to publish at a later time.
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/fsutil"
+	"github.com/pp/lnk/internal/idgen"
+)
+
+const (
+	// QueueFile is the filename for the persisted schedule queue.
+	QueueFile = "schedule.json"
+	// LockFile is the filename used to serialize schedule runners.
+	LockFile = "schedule.lock"
+	// QueueLockFile is the filename used to serialize queue updates.
+	QueueLockFile = "schedule.json.lock"
+	// LogFile is the filename for the runner outcome log.
+	LogFile = "schedule.log"
+)
+
+// Status is the state of a scheduled post.
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusPublishing Status = "publishing"
+	StatusPublished  Status = "published"
+	StatusFailed     Status = "failed"
+	StatusCanceled   Status = "canceled"
+	// StatusUnknown means the publish request may or may not have been
+	// accepted; the user has to check LinkedIn before retrying.
+	StatusUnknown Status = "unknown"
+)
+
+// Entry is a single scheduled post.
+type Entry struct {
+	ID          string    `json:"id"`
+	Text        string    `json:"text"`
+	At          time.Time `json:"at"`
+	Status      Status    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Attempts    int       `json:"attempts,omitempty"`
+	URN         string    `json:"urn,omitempty"`
+	PublishedAt time.Time `json:"publishedAt,omitempty"`
+	LastError   string    `json:"lastError,omitempty"`
+}
+
+// IsDue reports whether the entry is pending and its time has come.
+func (e *Entry) IsDue(now time.Time) bool {
+	return e.Status == StatusPending && !e.At.After(now)
+}
+
+// Queue manages the persisted list of scheduled posts.
+type Queue struct {
+	dir string
+}
+
+// ErrNotFound indicates no entry matches the given ID.
+var ErrNotFound = errors.New("scheduled post not found")
+
+// NewQueue creates a queue stored in the given directory.
+func NewQueue(dir string) *Queue {
+	return &Queue{dir: dir}
+}
+
+// Path returns the queue file path.
+func (q *Queue) Path() string {
+	return filepath.Join(q.dir, QueueFile)
+}
+
+// Load reads all entries from disk, ordered by scheduled time.
+func (q *Queue) Load() ([]Entry, error) {
+	data, err := os.ReadFile(q.Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Entry{}, nil
+		}
+		return nil, fmt.Errorf("failed to read schedule: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse schedule: %w", err)
+	}
+
+	sortEntries(entries)
+	return entries, nil
+}
+
+// Save writes all entries to disk atomically.
+func (q *Queue) Save(entries []Entry) error {
+	sortEntries(entries)
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schedule: %w", err)
+	}
+
+	if err := fsutil.WriteFileAtomic(q.Path(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write schedule: %w", err)
+	}
+	return nil
+}
+
+// update applies fn to the current entries under the queue lock and saves
+// the result, so concurrent commands and runners never overwrite each other.
+func (q *Queue) update(fn func(entries []Entry) ([]Entry, error)) error {
+	lock, err := lockQueue(q.dir)
+	if err != nil {
+		return fmt.Errorf("failed to lock schedule: %w", err)
+	}
+	defer func() { _ = lock.Release() }()
+
+	entries, err := q.Load()
+	if err != nil {
+		return err
+	}
+	entries, err = fn(entries)
+	if err != nil {
+		return err
+	}
+	return q.Save(entries)
+}
+
+// Add schedules a new post and returns the stored entry.
+func (q *Queue) Add(text string, at time.Time) (*Entry, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("post text cannot be empty")
+	}
+
+	entry := Entry{
+		ID:        idgen.New(),
+		Text:      text,
+		At:        at,
+		Status:    StatusPending,
+		CreatedAt: time.Now(),
+	}
+	err := q.update(func(entries []Entry) ([]Entry, error) {
+		return append(entries, entry), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Cancel marks a pending entry as canceled. Entries stuck in publishing
+// after a crashed runner, and entries with an unknown outcome, can be
+// canceled too once no runner is active.
+func (q *Queue) Cancel(id string) (*Entry, error) {
+	return q.transition(id, StatusCanceled, StatusPending, StatusPublishing, StatusUnknown)
+}
+
+// Retry puts a failed entry, an entry with an unknown outcome or an entry
+// stuck in publishing back into the queue, so the next run publishes it.
+// For unknown and publishing entries LinkedIn may already have accepted the
+// post, and retrying it may publish it twice.
+func (q *Queue) Retry(id string) (*Entry, error) {
+	return q.transition(id, StatusPending, StatusFailed, StatusPublishing, StatusUnknown)
+}
+
+// transition moves the entry with the given ID or unique ID prefix to
+// status, if it is currently in one of from.
+func (q *Queue) transition(id string, status Status, from ...Status) (*Entry, error) {
+	var changed Entry
+	err := q.update(func(entries []Entry) ([]Entry, error) {
+		idx := findEntry(entries, id)
+		if idx == -1 {
+			return nil, ErrNotFound
+		}
+		e := &entries[idx]
+		if !slices.Contains(from, e.Status) {
+			return nil, fmt.Errorf("cannot %s %s post", verbs[status], e.Status)
+		}
+		if e.Status == StatusPublishing {
+			if err := q.checkNoRunner(); err != nil {
+				return nil, err
+			}
+		}
+
+		e.Status = status
+		if status == StatusPending {
+			e.Attempts = 0
+			e.LastError = ""
+		}
+		changed = *e
+		return entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &changed, nil
+}
+
+// verbs names the action that moves an entry to a status, for errors.
+var verbs = map[Status]string{
+	StatusCanceled: "cancel",
+	StatusPending:  "retry",
+}
+
+// checkNoRunner returns ErrLocked if a runner holds the runner lock, in
+// which case a publishing entry is really being published right now.
+func (q *Queue) checkNoRunner() error {
+	lock, err := AcquireLock(q.dir)
+	if err != nil {
+		return err
+	}
+	return lock.Release()
+}
+
+// findEntry returns the index of the entry with the given ID or unique ID prefix.
+func findEntry(entries []Entry, id string) int {
+	match := -1
+	for i := range entries {
+		if entries[i].ID == id {
+			return i
+		}
+		if strings.HasPrefix(entries[i].ID, id) {
+			if match != -1 {
+				return -1 // Ambiguous prefix.
+			}
+			match = i
+		}
+	}
+	return match
+}
+
+// indexOf returns the index of the entry with exactly the given ID, or -1.
+func indexOf(entries []Entry, id string) int {
+	for i := range entries {
+		if entries[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// sortEntries orders entries by scheduled time, oldest first.
+func sortEntries(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].At.Before(entries[j].At)
+	})
+}
+
+// timeLayouts are the accepted formats for --at, in order of preference.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses a schedule time. Times without a zone are interpreted in loc.
+func ParseTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use e.g. 2026-11-01T09:00)", s)
+}
@@ -0,0 +1,160 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueueAddCancel(t *testing.T) {
+	q := NewQueue(t.TempDir())
+
+	later := time.Now().Add(2 * time.Hour)
+	sooner := time.Now().Add(1 * time.Hour)
+
+	first, err := q.Add("later post", later)
+	if err != nil {
+		t.Fatalf("Add() error: %v", err)
+	}
+	if _, err := q.Add("sooner post", sooner); err != nil {
+		t.Fatalf("Add() error: %v", err)
+	}
+
+	entries, err := q.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2", len(entries))
+	}
+	if entries[0].Text != "sooner post" {
+		t.Errorf("entries not sorted by time: first = %q", entries[0].Text)
+	}
+
+	canceled, err := q.Cancel(first.ID[:4])
+	if err != nil {
+		t.Fatalf("Cancel() error: %v", err)
+	}
+	if canceled.Status != StatusCanceled {
+		t.Errorf("Status = %q, want %q", canceled.Status, StatusCanceled)
+	}
+
+	if _, err := q.Cancel(first.ID); err == nil {
+		t.Error("expected error canceling an already canceled post")
+	}
+	if _, err := q.Cancel("nope"); err != ErrNotFound {
+		t.Errorf("Cancel(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestQueueRecoverStuckEntries(t *testing.T) {
+	dir := t.TempDir()
+	q := NewQueue(dir)
+	at := time.Now().Add(-time.Hour)
+	if err := q.Save([]Entry{
+		{ID: "aaaa0001", Text: "stuck", At: at, Status: StatusPublishing, Attempts: 1},
+		{ID: "bbbb0002", Text: "timed out", At: at, Status: StatusUnknown, Attempts: 1, LastError: "timeout"},
+		{ID: "cccc0003", Text: "posted", At: at, Status: StatusPublished},
+	}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	// A live runner may be publishing the stuck entry right now.
+	lock, err := AcquireLock(dir)
+	if err != nil {
+		t.Fatalf("AcquireLock() error: %v", err)
+	}
+	if _, err := q.Retry("aaaa"); err != ErrLocked {
+		t.Errorf("Retry() with an active runner error = %v, want ErrLocked", err)
+	}
+	if _, err := q.Cancel("aaaa"); err != ErrLocked {
+		t.Errorf("Cancel() with an active runner error = %v, want ErrLocked", err)
+	}
+	_ = lock.Release()
+
+	retried, err := q.Retry("aaaa")
+	if err != nil {
+		t.Fatalf("Retry() error: %v", err)
+	}
+	if retried.Status != StatusPending || retried.Attempts != 0 || !retried.IsDue(time.Now()) {
+		t.Errorf("retried = %+v", retried)
+	}
+
+	canceled, err := q.Cancel("bbbb")
+	if err != nil {
+		t.Fatalf("Cancel() error: %v", err)
+	}
+	if canceled.Status != StatusCanceled {
+		t.Errorf("Status = %q, want %q", canceled.Status, StatusCanceled)
+	}
+
+	if _, err := q.Retry("cccc"); err == nil {
+		t.Error("expected error retrying a published post")
+	}
+	if _, err := q.Cancel("cccc"); err == nil {
+		t.Error("expected error canceling a published post")
+	}
+}
+
+func TestQueueAddEmpty(t *testing.T) {
+	q := NewQueue(t.TempDir())
+	if _, err := q.Add("   ", time.Now()); err == nil {
+		t.Error("expected error for empty text")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("test", 2*60*60)
+
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2026-11-01T09:00", time.Date(2026, 11, 1, 9, 0, 0, 0, loc), false},
+		{"2026-11-01 09:00", time.Date(2026, 11, 1, 9, 0, 0, 0, loc), false},
+		{"2026-11-01T09:00:30", time.Date(2026, 11, 1, 9, 0, 30, 0, loc), false},
+		{"2026-11-01T09:00:00Z", time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), false},
+		{"tomorrow", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTime(tt.input, loc)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcquireLock(t *testing.T) {
+	dir := t.TempDir()
+
+	lock, err := AcquireLock(dir)
+	if err != nil {
+		t.Fatalf("AcquireLock() error: %v", err)
+	}
+
+	if _, err := AcquireLock(dir); err != ErrLocked {
+		t.Errorf("second AcquireLock() error = %v, want ErrLocked", err)
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("Release() error: %v", err)
+	}
+
+	lock, err = AcquireLock(dir)
+	if err != nil {
+		t.Fatalf("AcquireLock() after release error: %v", err)
+	}
+	_ = lock.Release()
+}
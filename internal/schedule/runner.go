@@ -0,0 +1,264 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+// Publisher creates posts. *api.Client satisfies it.
+type Publisher interface {
+	CreatePost(ctx context.Context, text string) (*api.Post, error)
+}
+
+// Outcome records the result of publishing one scheduled post.
+type Outcome struct {
+	Time     time.Time `json:"time"`
+	ID       string    `json:"id"`
+	Status   Status    `json:"status"`
+	URN      string    `json:"urn,omitempty"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Runner publishes due posts from a queue.
+type Runner struct {
+	Queue     *Queue
+	Publisher Publisher
+	// MaxAttempts is the number of tries per post before it is marked failed.
+	// Only failures where the post was clearly not accepted are retried.
+	MaxAttempts int
+	// Backoff is the delay before the first retry; it doubles on each retry.
+	Backoff time.Duration
+	// Now returns the current time (overridable for tests).
+	Now func() time.Time
+	// DryRun passes due posts to a Publisher that is expected not to send
+	// them (such as a client in dry-run mode) and leaves the queue and log
+	// untouched. Outcomes report the posts as still pending.
+	DryRun bool
+}
+
+// NewRunner creates a runner with default retry settings.
+func NewRunner(queue *Queue, publisher Publisher) *Runner {
+	return &Runner{
+		Queue:       queue,
+		Publisher:   publisher,
+		MaxAttempts: 3,
+		Backoff:     5 * time.Second,
+		Now:         time.Now,
+	}
+}
+
+// RunDue publishes every due post once. It holds the runner lock for the
+// whole run so concurrent runners never publish the same post twice, and
+// takes the queue lock around each queue update so posts added or canceled
+// during the run are not lost.
+func (r *Runner) RunDue(ctx context.Context) ([]Outcome, error) {
+	lock, err := AcquireLock(r.Queue.dir)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = lock.Release() }()
+
+	entries, err := r.Queue.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var outcomes []Outcome
+	now := r.Now()
+	for _, e := range entries {
+		if !e.IsDue(now) {
+			continue
+		}
+		if r.DryRun {
+			if _, err := r.Publisher.CreatePost(ctx, e.Text); err != nil && !errors.Is(err, api.ErrDryRun) {
+				return outcomes, err
+			}
+			outcomes = append(outcomes, r.outcome(&e))
+			continue
+		}
+
+		// Re-check the entry right before publishing: it may have been
+		// canceled since the queue was loaded.
+		entry, err := r.claim(e.ID, now)
+		if err != nil {
+			return outcomes, err
+		}
+		if entry == nil {
+			continue
+		}
+
+		outcome := r.publish(ctx, entry)
+		outcomes = append(outcomes, outcome)
+		_ = r.appendLog(outcome)
+
+		if err := r.store(entry); err != nil {
+			return outcomes, err
+		}
+		if ctx.Err() != nil {
+			return outcomes, ctx.Err()
+		}
+	}
+
+	return outcomes, nil
+}
+
+// claim marks the entry with the given ID as publishing if it is still due,
+// returning a copy of it, or nil if it is no longer due.
+//
+// The in-flight state is persisted first: if we crash mid-publish the post
+// stays "publishing" rather than being posted again by the next run.
+func (r *Runner) claim(id string, now time.Time) (*Entry, error) {
+	var claimed *Entry
+	err := r.Queue.update(func(entries []Entry) ([]Entry, error) {
+		idx := indexOf(entries, id)
+		if idx == -1 || !entries[idx].IsDue(now) {
+			return entries, nil
+		}
+		entries[idx].Status = StatusPublishing
+		entry := entries[idx]
+		claimed = &entry
+		return entries, nil
+	})
+	return claimed, err
+}
+
+// store writes a published or failed entry back into the current queue.
+func (r *Runner) store(entry *Entry) error {
+	return r.Queue.update(func(entries []Entry) ([]Entry, error) {
+		if idx := indexOf(entries, entry.ID); idx != -1 {
+			entries[idx] = *entry
+		}
+		return entries, nil
+	})
+}
+
+// publish sends a single entry, retrying failures where the post was clearly
+// not accepted.
+func (r *Runner) publish(ctx context.Context, entry *Entry) Outcome {
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	backoff := r.Backoff
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		entry.Attempts++
+
+		post, err := r.Publisher.CreatePost(ctx, entry.Text)
+		if err == nil {
+			entry.Status = StatusPublished
+			entry.URN = post.URN
+			entry.PublishedAt = r.Now()
+			entry.LastError = ""
+			return r.outcome(entry)
+		}
+
+		lastErr = err
+		if !isRetryable(err) {
+			entry.Status = failureStatus(err)
+			entry.LastError = err.Error()
+			return r.outcome(entry)
+		}
+		if attempt == maxAttempts {
+			break
+		}
+
+		if err := sleepContext(ctx, backoff); err != nil {
+			lastErr = err
+			break
+		}
+		backoff *= 2
+	}
+
+	entry.Status = StatusFailed
+	entry.LastError = lastErr.Error()
+	return r.outcome(entry)
+}
+
+// outcome builds the log record for an entry's current state.
+func (r *Runner) outcome(entry *Entry) Outcome {
+	return Outcome{
+		Time:     r.Now(),
+		ID:       entry.ID,
+		Status:   entry.Status,
+		URN:      entry.URN,
+		Attempts: entry.Attempts,
+		Error:    entry.LastError,
+	}
+}
+
+// appendLog writes an outcome to the runner log as a JSON line.
+func (r *Runner) appendLog(outcome Outcome) error {
+	f, err := os.OpenFile(filepath.Join(r.Queue.dir, LogFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open schedule log: %w", err)
+	}
+	defer f.Close()
+
+	return json.NewEncoder(f).Encode(outcome)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isRetryable reports whether a publish error is safe to retry. CreatePost
+// is not idempotent, so only failures where LinkedIn clearly did not accept
+// the post qualify: rate limiting, or a connection that was never made.
+func isRetryable(err error) bool {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case api.ErrCodeRateLimited:
+		return true
+	case api.ErrCodeNetworkError:
+		return notConnected(err)
+	default:
+		return false
+	}
+}
+
+// notConnected reports whether err shows the request never reached the server.
+func notConnected(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.As(err, &dnsErr)
+}
+
+// failureStatus returns the status for a publish that failed with a
+// non-retryable error. Timeouts, dropped connections and server errors may
+// have happened after LinkedIn created the post, so they are reported as
+// unknown rather than failed.
+func failureStatus(err error) Status {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return StatusFailed
+	}
+	switch apiErr.Code {
+	case api.ErrCodeNetworkError, api.ErrCodeServerError:
+		return StatusUnknown
+	default:
+		return StatusFailed
+	}
+}
@@ -0,0 +1,282 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+// fakePublisher returns queued errors before succeeding.
+type fakePublisher struct {
+	errs  []error
+	calls int
+	texts []string
+}
+
+func (f *fakePublisher) CreatePost(_ context.Context, text string) (*api.Post, error) {
+	f.calls++
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	f.texts = append(f.texts, text)
+	return &api.Post{URN: "urn:li:share:1", Text: text}, nil
+}
+
+func TestRunnerRunDue(t *testing.T) {
+	dir := t.TempDir()
+	q := NewQueue(dir)
+
+	now := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	if _, err := q.Add("due", now.Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Add("future", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	pub := &fakePublisher{}
+	r := NewRunner(q, pub)
+	r.Now = func() time.Time { return now }
+
+	outcomes, err := r.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue() error: %v", err)
+	}
+	if len(outcomes) != 1 || outcomes[0].Status != StatusPublished {
+		t.Fatalf("outcomes = %+v, want one published", outcomes)
+	}
+	if len(pub.texts) != 1 || pub.texts[0] != "due" {
+		t.Errorf("published texts = %v, want [due]", pub.texts)
+	}
+
+	entries, _ := q.Load()
+	if entries[0].Status != StatusPublished || entries[0].URN != "urn:li:share:1" {
+		t.Errorf("entry = %+v, want published with URN", entries[0])
+	}
+	if entries[1].Status != StatusPending {
+		t.Errorf("future entry status = %q, want pending", entries[1].Status)
+	}
+
+	// A second run must not publish again.
+	if _, err := r.RunDue(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if pub.calls != 1 {
+		t.Errorf("CreatePost called %d times, want 1", pub.calls)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, LogFile)); err != nil {
+		t.Errorf("outcome log not written: %v", err)
+	}
+}
+
+func TestRunnerRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		errs       []error
+		wantStatus Status
+		wantCalls  int
+	}{
+		{
+			name:       "rate limited then success",
+			errs:       []error{&api.Error{Code: api.ErrCodeRateLimited}},
+			wantStatus: StatusPublished,
+			wantCalls:  2,
+		},
+		{
+			name: "connection refused then success",
+			errs: []error{&api.Error{
+				Code: api.ErrCodeNetworkError,
+				Err:  &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+			}},
+			wantStatus: StatusPublished,
+			wantCalls:  2,
+		},
+		{
+			name: "exhausts attempts",
+			errs: []error{
+				&api.Error{Code: api.ErrCodeRateLimited},
+				&api.Error{Code: api.ErrCodeRateLimited},
+				&api.Error{Code: api.ErrCodeRateLimited},
+			},
+			wantStatus: StatusFailed,
+			wantCalls:  3,
+		},
+		{
+			name:       "timeout is not retried",
+			errs:       []error{&api.Error{Code: api.ErrCodeNetworkError, Err: context.DeadlineExceeded}},
+			wantStatus: StatusUnknown,
+			wantCalls:  1,
+		},
+		{
+			name:       "server error is not retried",
+			errs:       []error{&api.Error{Code: api.ErrCodeServerError}},
+			wantStatus: StatusUnknown,
+			wantCalls:  1,
+		},
+		{
+			name:       "permanent error",
+			errs:       []error{&api.Error{Code: api.ErrCodeAuthExpired}},
+			wantStatus: StatusFailed,
+			wantCalls:  1,
+		},
+		{
+			name:       "non-api error",
+			errs:       []error{errors.New("boom")},
+			wantStatus: StatusFailed,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewQueue(t.TempDir())
+			if _, err := q.Add("post", time.Now().Add(-time.Minute)); err != nil {
+				t.Fatal(err)
+			}
+
+			pub := &fakePublisher{errs: tt.errs}
+			r := NewRunner(q, pub)
+			r.Backoff = time.Millisecond
+
+			outcomes, err := r.RunDue(context.Background())
+			if err != nil {
+				t.Fatalf("RunDue() error: %v", err)
+			}
+			if len(outcomes) != 1 {
+				t.Fatalf("len(outcomes) = %d, want 1", len(outcomes))
+			}
+			if outcomes[0].Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", outcomes[0].Status, tt.wantStatus)
+			}
+			if pub.calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", pub.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRunnerLocked(t *testing.T) {
+	dir := t.TempDir()
+	lock, err := AcquireLock(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = lock.Release() }()
+
+	r := NewRunner(NewQueue(dir), &fakePublisher{})
+	if _, err := r.RunDue(context.Background()); err != ErrLocked {
+		t.Errorf("RunDue() error = %v, want ErrLocked", err)
+	}
+}
+
+// cancelingPublisher cancels a queued entry while the runner is publishing.
+type cancelingPublisher struct {
+	queue    *Queue
+	cancelID string
+	texts    []string
+}
+
+func (p *cancelingPublisher) CreatePost(_ context.Context, text string) (*api.Post, error) {
+	if p.cancelID != "" {
+		if _, err := p.queue.Cancel(p.cancelID); err != nil {
+			return nil, err
+		}
+		if _, err := p.queue.Add("added mid-run", time.Now().Add(time.Hour)); err != nil {
+			return nil, err
+		}
+		p.cancelID = ""
+	}
+	p.texts = append(p.texts, text)
+	return &api.Post{URN: "urn:li:share:1", Text: text}, nil
+}
+
+func TestRunnerConcurrentUpdates(t *testing.T) {
+	q := NewQueue(t.TempDir())
+
+	now := time.Now()
+	if _, err := q.Add("first", now.Add(-2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	second, err := q.Add("second", now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pub := &cancelingPublisher{queue: q, cancelID: second.ID}
+	r := NewRunner(q, pub)
+
+	if _, err := r.RunDue(context.Background()); err != nil {
+		t.Fatalf("RunDue() error: %v", err)
+	}
+	if len(pub.texts) != 1 || pub.texts[0] != "first" {
+		t.Errorf("published texts = %v, want [first]", pub.texts)
+	}
+
+	entries, err := q.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses := map[string]Status{}
+	for _, e := range entries {
+		statuses[e.Text] = e.Status
+	}
+	want := map[string]Status{
+		"first":         StatusPublished,
+		"second":        StatusCanceled,
+		"added mid-run": StatusPending,
+	}
+	for text, status := range want {
+		if statuses[text] != status {
+			t.Errorf("status of %q = %q, want %q", text, statuses[text], status)
+		}
+	}
+}
+
+// dryRunPublisher fails every post with api.ErrDryRun, like a client in
+// dry-run mode.
+type dryRunPublisher struct {
+	calls int
+}
+
+func (p *dryRunPublisher) CreatePost(context.Context, string) (*api.Post, error) {
+	p.calls++
+	return nil, api.ErrDryRun
+}
+
+func TestRunnerDryRun(t *testing.T) {
+	dir := t.TempDir()
+	q := NewQueue(dir)
+	if _, err := q.Add("due", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	pub := &dryRunPublisher{}
+	r := NewRunner(q, pub)
+	r.DryRun = true
+
+	outcomes, err := r.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue() error: %v", err)
+	}
+	if pub.calls != 1 || len(outcomes) != 1 || outcomes[0].Status != StatusPending {
+		t.Errorf("calls = %d, outcomes = %+v, want one pending outcome", pub.calls, outcomes)
+	}
+
+	entries, _ := q.Load()
+	if entries[0].Status != StatusPending || entries[0].Attempts != 0 {
+		t.Errorf("entry = %+v, want untouched pending entry", entries[0])
+	}
+	if _, err := os.Stat(filepath.Join(dir, LogFile)); !os.IsNotExist(err) {
+		t.Errorf("outcome log written in dry run: %v", err)
+	}
+}
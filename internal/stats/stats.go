@@ -0,0 +1,115 @@
+// Package stats records post engagement snapshots in a local SQLite
+// database so engagement can be charted over time.
+package stats
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+// DBFile is the filename for the engagement history database.
+const DBFile = "stats.db"
+
+const schema = `
+CREATE TABLE IF NOT EXISTS snapshots (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	urn          TEXT    NOT NULL,
+	fetched_at   INTEGER NOT NULL,
+	likes        INTEGER NOT NULL,
+	comments     INTEGER NOT NULL,
+	shares       INTEGER NOT NULL,
+	impressions  INTEGER NOT NULL,
+	unique_views INTEGER NOT NULL,
+	has_views    INTEGER NOT NULL,
+	reactions    TEXT    NOT NULL DEFAULT '{}'
+);
+CREATE INDEX IF NOT EXISTS snapshots_urn_time ON snapshots (urn, fetched_at);
+`
+
+// Store is the engagement history database.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens or creates the history database in the given directory.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, DBFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stats database: %w", err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize stats database: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Record appends a snapshot.
+func (s *Store) Record(st *api.PostStats) error {
+	reactions, err := json.Marshal(st.Reactions)
+	if err != nil {
+		return fmt.Errorf("failed to encode reactions: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		INSERT INTO snapshots
+			(urn, fetched_at, likes, comments, shares, impressions, unique_views, has_views, reactions)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		st.URN, st.FetchedAt.UnixMilli(), st.Likes, st.Comments, st.Shares,
+		st.Impressions, st.UniqueViews, st.HasViews, string(reactions))
+	if err != nil {
+		return fmt.Errorf("failed to record snapshot: %w", err)
+	}
+	return nil
+}
+
+// History returns every snapshot for a post, oldest first.
+func (s *Store) History(urn string) ([]api.PostStats, error) {
+	rows, err := s.db.Query(`
+		SELECT fetched_at, likes, comments, shares, impressions, unique_views, has_views, reactions
+		FROM snapshots
+		WHERE urn = ?
+		ORDER BY fetched_at, id`, urn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []api.PostStats{}
+	for rows.Next() {
+		st := api.PostStats{URN: urn}
+		var fetchedAt int64
+		var reactions string
+		if err := rows.Scan(&fetchedAt, &st.Likes, &st.Comments, &st.Shares,
+			&st.Impressions, &st.UniqueViews, &st.HasViews, &reactions); err != nil {
+			return nil, fmt.Errorf("failed to read history: %w", err)
+		}
+		st.FetchedAt = time.UnixMilli(fetchedAt)
+		if err := json.Unmarshal([]byte(reactions), &st.Reactions); err != nil {
+			return nil, fmt.Errorf("failed to decode reactions: %w", err)
+		}
+		history = append(history, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	return history, nil
+}
@@ -0,0 +1,51 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+func TestRecordHistory(t *testing.T) {
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer s.Close()
+
+	start := time.Now().Truncate(time.Millisecond)
+	snapshots := []api.PostStats{
+		{URN: "urn:li:activity:1", Likes: 5, FetchedAt: start.Add(time.Hour)},
+		{URN: "urn:li:activity:1", Likes: 1, Impressions: 40, HasViews: true,
+			Reactions: map[string]int{api.ReactionLike: 1}, FetchedAt: start},
+		{URN: "urn:li:activity:2", Likes: 9, FetchedAt: start},
+	}
+	for i := range snapshots {
+		if err := s.Record(&snapshots[i]); err != nil {
+			t.Fatalf("Record() error: %v", err)
+		}
+	}
+
+	history, err := s.History("urn:li:activity:1")
+	if err != nil {
+		t.Fatalf("History() error: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("len(history) = %d, want 2", len(history))
+	}
+
+	first := history[0]
+	if first.Likes != 1 || first.Impressions != 40 || !first.HasViews {
+		t.Errorf("first = %+v, want oldest snapshot first", first)
+	}
+	if !first.FetchedAt.Equal(start) {
+		t.Errorf("FetchedAt = %v, want %v", first.FetchedAt, start)
+	}
+	if first.Reactions[api.ReactionLike] != 1 {
+		t.Errorf("Reactions = %v", first.Reactions)
+	}
+	if history[1].Likes != 5 {
+		t.Errorf("second Likes = %d, want 5", history[1].Likes)
+	}
+}
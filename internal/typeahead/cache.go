@@ -0,0 +1,144 @@
+// Package typeahead caches typeahead lookups, so names such as "Berlin" or
+// "Acme" resolve to the same URN without asking LinkedIn every time.
+package typeahead
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+	"github.com/pp/lnk/internal/fsutil"
+)
+
+// File is the filename of the typeahead cache.
+const File = "typeahead-cache.json"
+
+// DefaultTTL is how long cached hits are used before they are looked up
+// again.
+const DefaultTTL = 30 * 24 * time.Hour
+
+// MaxEntries is how many lookups the cache keeps; the oldest are dropped
+// first.
+const MaxEntries = 500
+
+// Entry is the cached result of one lookup.
+type Entry struct {
+	Kind      string             `json:"kind"`
+	Text      string             `json:"text"`
+	Hits      []api.TypeaheadHit `json:"hits"`
+	FetchedAt time.Time          `json:"fetchedAt"`
+}
+
+// Cache manages the typeahead cache file.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+// NewCache creates a cache in the given directory whose entries expire
+// after ttl.
+func NewCache(dir string, ttl time.Duration) *Cache {
+	return &Cache{dir: dir, ttl: ttl}
+}
+
+// Path returns the cache file path.
+func (c *Cache) Path() string {
+	return filepath.Join(c.dir, File)
+}
+
+// normalize returns the form of text lookups are keyed by, so "berlin" and
+// " Berlin" share an entry.
+func normalize(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// Get returns the hits cached for kind and text, if they are younger than
+// the cache's TTL at now.
+func (c *Cache) Get(kind, text string, now time.Time) ([]api.TypeaheadHit, bool, error) {
+	entries, err := c.load()
+	if err != nil {
+		return nil, false, err
+	}
+	text = normalize(text)
+	for _, e := range entries {
+		if e.Kind == kind && e.Text == text {
+			if now.Sub(e.FetchedAt) >= c.ttl {
+				return nil, false, nil
+			}
+			return e.Hits, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// Put caches hits for kind and text, fetched at now, replacing any earlier
+// entry. Empty results are not cached, since a page or place that does not
+// exist yet may be created at any time; they only drop the earlier entry.
+func (c *Cache) Put(kind, text string, hits []api.TypeaheadHit, now time.Time) error {
+	entries, err := c.load()
+	if err != nil {
+		return err
+	}
+	text = normalize(text)
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.Kind != kind || e.Text != text {
+			kept = append(kept, e)
+		}
+	}
+	if len(hits) == 0 && len(kept) == len(entries) {
+		return nil
+	}
+	if len(hits) > 0 {
+		kept = append(kept, Entry{Kind: kind, Text: text, Hits: hits, FetchedAt: now})
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].FetchedAt.Before(kept[j].FetchedAt) })
+	if len(kept) > MaxEntries {
+		kept = kept[len(kept)-MaxEntries:]
+	}
+	return c.save(kept)
+}
+
+// Clear removes all cached lookups.
+func (c *Cache) Clear() error {
+	if err := os.Remove(c.Path()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to clear typeahead cache: %w", err)
+	}
+	return nil
+}
+
+// load reads all entries, oldest first.
+func (c *Cache) load() ([]Entry, error) {
+	data, err := os.ReadFile(c.Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read typeahead cache: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse typeahead cache: %w", err)
+	}
+	return entries, nil
+}
+
+// save writes all entries to disk atomically.
+func (c *Cache) save(entries []Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal typeahead cache: %w", err)
+	}
+
+	if err := fsutil.WriteFileAtomic(c.Path(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write typeahead cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package typeahead
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pp/lnk/internal/api"
+)
+
+func TestCacheGetPut(t *testing.T) {
+	c := NewCache(t.TempDir(), time.Hour)
+	now := time.Now()
+
+	if _, ok, err := c.Get(api.TypeaheadGeo, "Berlin", now); err != nil || ok {
+		t.Fatalf("Get() on empty cache = %v, %v", ok, err)
+	}
+
+	hits := []api.TypeaheadHit{{URN: "urn:li:geo:103035651", ID: "103035651", Text: "Berlin, Germany"}}
+	if err := c.Put(api.TypeaheadGeo, "Berlin", hits, now); err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+
+	got, ok, err := c.Get(api.TypeaheadGeo, "  berlin ", now.Add(time.Minute))
+	if err != nil || !ok || len(got) != 1 || got[0] != hits[0] {
+		t.Errorf("Get() = %+v, %v, %v", got, ok, err)
+	}
+	if _, ok, _ := c.Get(api.TypeaheadCompany, "Berlin", now); ok {
+		t.Error("Get() matched a different kind")
+	}
+	if _, ok, _ := c.Get(api.TypeaheadGeo, "Berlin", now.Add(time.Hour)); ok {
+		t.Error("Get() returned an expired entry")
+	}
+
+	info, err := os.Stat(c.Path())
+	if err != nil {
+		t.Fatalf("Stat() error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	// An empty result is not cached and drops the earlier entry.
+	if err := c.Put(api.TypeaheadGeo, "Berlin", []api.TypeaheadHit{}, now); err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+	if got, ok, _ := c.Get(api.TypeaheadGeo, "Berlin", now); ok {
+		t.Errorf("Get() after empty Put() = %+v, %v", got, ok)
+	}
+	if err := c.Put(api.TypeaheadGeo, "Atlantis", nil, now); err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+	if _, ok, _ := c.Get(api.TypeaheadGeo, "Atlantis", now); ok {
+		t.Error("Get() found an empty result")
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if _, ok, _ := c.Get(api.TypeaheadGeo, "Berlin", now); ok {
+		t.Error("Get() after Clear() found an entry")
+	}
+}
+
+func TestCacheDropsOldest(t *testing.T) {
+	c := NewCache(t.TempDir(), DefaultTTL)
+	now := time.Now()
+
+	hits := []api.TypeaheadHit{{URN: "urn:li:fsd_skill:1", ID: "1", Text: "Go"}}
+	for i := range MaxEntries + 1 {
+		if err := c.Put(api.TypeaheadSkill, fmt.Sprintf("skill %d", i), hits, now.Add(time.Duration(i)*time.Second)); err != nil {
+			t.Fatalf("Put() error: %v", err)
+		}
+	}
+
+	entries, err := c.load()
+	if err != nil {
+		t.Fatalf("load() error: %v", err)
+	}
+	if len(entries) != MaxEntries {
+		t.Errorf("len = %d, want %d", len(entries), MaxEntries)
+	}
+	if _, ok, _ := c.Get(api.TypeaheadSkill, "skill 0", now); ok {
+		t.Error("oldest entry was kept")
+	}
+}
@@ -0,0 +1,111 @@
+// Package undo tracks the most recently created post during its undo
+// window, so 'lnk undo' can delete it from another terminal.
+package undo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pp/lnk/internal/fsutil"
+)
+
+// File is the filename for the pending undo record.
+const File = "undo.json"
+
+// Record is a post that can still be undone.
+type Record struct {
+	URN       string    `json:"urn"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Undone is set once the post has been deleted by 'lnk undo'.
+	Undone bool `json:"undone,omitempty"`
+}
+
+// Open reports whether the record can still be undone at now.
+func (r *Record) Open(now time.Time) bool {
+	return !r.Undone && now.Before(r.ExpiresAt)
+}
+
+// ErrNothing indicates there is no post to undo.
+var ErrNothing = errors.New("nothing to undo")
+
+// Store persists the undo record.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Path returns the record file path.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, File)
+}
+
+// Save writes the record atomically, replacing any previous one.
+func (s *Store) Save(r *Record) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal undo record: %w", err)
+	}
+
+	if err := fsutil.WriteFileAtomic(s.Path(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write undo record: %w", err)
+	}
+	return nil
+}
+
+// Load returns the current record, or ErrNothing if there is none.
+func (s *Store) Load() (*Record, error) {
+	data, err := os.ReadFile(s.Path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNothing
+		}
+		return nil, fmt.Errorf("failed to read undo record: %w", err)
+	}
+
+	var r Record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse undo record: %w", err)
+	}
+	return &r, nil
+}
+
+// Pending returns the record if it can still be undone at now, or
+// ErrNothing.
+func (s *Store) Pending(now time.Time) (*Record, error) {
+	r, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+	if !r.Open(now) {
+		return nil, ErrNothing
+	}
+	return r, nil
+}
+
+// Clear removes the record if it is for urn. Records for other posts are
+// left alone, so a newer post's window is never closed by an older one.
+func (s *Store) Clear(urn string) error {
+	r, err := s.Load()
+	if errors.Is(err, ErrNothing) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if r.URN != urn {
+		return nil
+	}
+	if err := os.Remove(s.Path()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove undo record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package undo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStorePending(t *testing.T) {
+	s := NewStore(t.TempDir())
+	now := time.Now()
+
+	if _, err := s.Pending(now); !errors.Is(err, ErrNothing) {
+		t.Fatalf("Pending() on empty store error = %v, want ErrNothing", err)
+	}
+
+	r := &Record{URN: "urn:li:share:1", CreatedAt: now, ExpiresAt: now.Add(30 * time.Second)}
+	if err := s.Save(r); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	got, err := s.Pending(now)
+	if err != nil {
+		t.Fatalf("Pending() error: %v", err)
+	}
+	if got.URN != r.URN {
+		t.Errorf("URN = %q, want %q", got.URN, r.URN)
+	}
+
+	if _, err := s.Pending(now.Add(time.Minute)); !errors.Is(err, ErrNothing) {
+		t.Errorf("Pending() after expiry error = %v, want ErrNothing", err)
+	}
+
+	got.Undone = true
+	if err := s.Save(got); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if _, err := s.Pending(now); !errors.Is(err, ErrNothing) {
+		t.Errorf("Pending() after undo error = %v, want ErrNothing", err)
+	}
+}
+
+func TestStoreClear(t *testing.T) {
+	s := NewStore(t.TempDir())
+	now := time.Now()
+
+	if err := s.Save(&Record{URN: "urn:li:share:2", ExpiresAt: now.Add(time.Minute)}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	// Clearing an older post must not close the newer post's window.
+	if err := s.Clear("urn:li:share:1"); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if _, err := s.Pending(now); err != nil {
+		t.Errorf("Pending() after clearing other URN error = %v", err)
+	}
+
+	if err := s.Clear("urn:li:share:2"); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if _, err := s.Load(); !errors.Is(err, ErrNothing) {
+		t.Errorf("Load() after Clear error = %v, want ErrNothing", err)
+	}
+}
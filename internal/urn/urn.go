@@ -0,0 +1,346 @@
+// Package urn parses, validates and converts LinkedIn URNs such as
+// urn:li:activity:7123 or composite ones like
+// urn:li:fsd_update:(urn:li:activity:7123,MAIN_FEED,EMPTY,DEFAULT,false).
+package urn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Namespace is the URN namespace LinkedIn uses.
+const Namespace = "li"
+
+// URN is a parsed LinkedIn URN.
+type URN struct {
+	// Type is the entity type, such as "activity" or "fsd_profile".
+	Type string
+	// ID is everything after the type: a plain ID or a parenthesized tuple.
+	ID string
+}
+
+// Kind groups entity types that refer to the same sort of thing.
+type Kind string
+
+// URN kinds.
+const (
+	KindPost         Kind = "post"
+	KindComment      Kind = "comment"
+	KindProfile      Kind = "profile"
+	KindCompany      Kind = "company"
+	KindJob          Kind = "job"
+	KindConversation Kind = "conversation"
+	KindSchool       Kind = "school"
+	KindGroup        Kind = "group"
+	KindEvent        Kind = "event"
+	KindUnknown      Kind = "unknown"
+)
+
+// Entity types used in conversions.
+const (
+	TypeActivity    = "activity"
+	TypeShare       = "share"
+	TypeUGCPost     = "ugcPost"
+	TypeMember      = "member"
+	TypeFSDProfile  = "fsd_profile"
+	TypeProfile     = "fs_profile"
+	TypeMiniProfile = "fs_miniProfile"
+)
+
+var kinds = map[string]Kind{
+	TypeActivity:               KindPost,
+	TypeShare:                  KindPost,
+	TypeUGCPost:                KindPost,
+	"comment":                  KindComment,
+	"fsd_comment":              KindComment,
+	TypeMember:                 KindProfile,
+	"person":                   KindProfile,
+	TypeFSDProfile:             KindProfile,
+	TypeProfile:                KindProfile,
+	TypeMiniProfile:            KindProfile,
+	"company":                  KindCompany,
+	"organization":             KindCompany,
+	"fsd_company":              KindCompany,
+	"fs_normalized_company":    KindCompany,
+	"jobPosting":               KindJob,
+	"fsd_jobPosting":           KindJob,
+	"fs_normalized_jobPosting": KindJob,
+	"fs_conversation":          KindConversation,
+	"msg_conversation":         KindConversation,
+	"school":                   KindSchool,
+	"fsd_school":               KindSchool,
+	"fs_normalized_school":     KindSchool,
+	"group":                    KindGroup,
+	"fsd_group":                KindGroup,
+	"fs_group":                 KindGroup,
+	"event":                    KindEvent,
+	"fsd_event":                KindEvent,
+	"professionalEvent":        KindEvent,
+	"fs_professionalEvent":     KindEvent,
+}
+
+// updateTypes are composite feed update types that wrap an activity URN.
+var updateTypes = map[string]bool{
+	"fs_updateV2":   true,
+	"fs_update":     true,
+	"fsd_update":    true,
+	"fs_feedUpdate": true,
+}
+
+// profileTypes share the same profile ID (ACoAA...) and convert freely.
+var profileTypes = map[string]bool{
+	TypeFSDProfile:  true,
+	TypeProfile:     true,
+	TypeMiniProfile: true,
+}
+
+var reType = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// ErrNeedsLookup indicates a conversion needs an API lookup but none was
+// provided.
+var ErrNeedsLookup = errors.New("conversion needs an API lookup")
+
+// New returns a URN of the given type and ID.
+func New(typ, id string) URN {
+	return URN{Type: typ, ID: id}
+}
+
+// Parse parses and validates a LinkedIn URN.
+func Parse(s string) (URN, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(s), "urn:"+Namespace+":")
+	if !ok {
+		return URN{}, fmt.Errorf("invalid URN %q: must start with urn:%s:", s, Namespace)
+	}
+
+	typ, id, ok := strings.Cut(rest, ":")
+	if !ok || !reType.MatchString(typ) {
+		return URN{}, fmt.Errorf("invalid URN %q: missing entity type", s)
+	}
+	if id == "" {
+		return URN{}, fmt.Errorf("invalid URN %q: missing ID", s)
+	}
+
+	u := URN{Type: typ, ID: id}
+	if strings.HasPrefix(id, "(") {
+		elements, err := splitTuple(id)
+		if err != nil {
+			return URN{}, fmt.Errorf("invalid URN %q: %w", s, err)
+		}
+		for _, e := range elements {
+			if strings.HasPrefix(e, "urn:") {
+				if _, err := Parse(e); err != nil {
+					return URN{}, fmt.Errorf("invalid URN %q: %w", s, err)
+				}
+			}
+		}
+	} else if strings.ContainsAny(id, "(),") {
+		return URN{}, fmt.Errorf("invalid URN %q: unexpected tuple characters in ID", s)
+	}
+
+	return u, nil
+}
+
+// MustParse is like Parse but panics on invalid input. It is intended for
+// constants and tests.
+func MustParse(s string) URN {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// splitTuple splits "(a,b,(c,d))" into its top-level elements.
+func splitTuple(id string) ([]string, error) {
+	if !strings.HasSuffix(id, ")") {
+		return nil, errors.New("unterminated tuple")
+	}
+
+	var elements []string
+	depth, start := 0, 1
+	for i, r := range id {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 || (depth == 0 && i != len(id)-1) {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 1 {
+				elements = append(elements, id[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	elements = append(elements, id[start:len(id)-1])
+
+	for _, e := range elements {
+		if e == "" {
+			return nil, errors.New("empty tuple element")
+		}
+	}
+	return elements, nil
+}
+
+// String returns the URN in canonical form.
+func (u URN) String() string {
+	if u.Type == "" {
+		return ""
+	}
+	return "urn:" + Namespace + ":" + u.Type + ":" + u.ID
+}
+
+// IsZero reports whether u is the zero URN.
+func (u URN) IsZero() bool {
+	return u.Type == "" && u.ID == ""
+}
+
+// IsComposite reports whether the ID is a tuple.
+func (u URN) IsComposite() bool {
+	return strings.HasPrefix(u.ID, "(")
+}
+
+// Elements returns the top-level tuple elements of a composite URN, or nil.
+func (u URN) Elements() []string {
+	if !u.IsComposite() {
+		return nil
+	}
+	elements, err := splitTuple(u.ID)
+	if err != nil {
+		return nil
+	}
+	return elements
+}
+
+// Nested returns the URNs embedded as tuple elements of a composite URN.
+func (u URN) Nested() []URN {
+	var nested []URN
+	for _, e := range u.Elements() {
+		if n, err := Parse(e); err == nil {
+			nested = append(nested, n)
+		}
+	}
+	return nested
+}
+
+// Kind returns what the URN refers to. Composite feed updates are posts.
+func (u URN) Kind() Kind {
+	if k, ok := kinds[u.Type]; ok {
+		return k
+	}
+	if updateTypes[u.Type] {
+		if _, ok := u.Activity(); ok {
+			return KindPost
+		}
+	}
+	return KindUnknown
+}
+
+// Pretty returns a compact human-readable form, such as "activity 7123"
+// or "fsd_update(activity 7123, MAIN_FEED)".
+func (u URN) Pretty() string {
+	if !u.IsComposite() {
+		return u.Type + " " + u.ID
+	}
+
+	elements := u.Elements()
+	parts := make([]string, len(elements))
+	for i, e := range elements {
+		if n, err := Parse(e); err == nil {
+			parts[i] = n.Pretty()
+		} else {
+			parts[i] = e
+		}
+	}
+	return u.Type + "(" + strings.Join(parts, ", ") + ")"
+}
+
+// Activity returns the activity URN for an activity or a composite feed
+// update that wraps one. Share and ugcPost URNs need a lookup; see
+// ToActivity.
+func (u URN) Activity() (URN, bool) {
+	if u.Type == TypeActivity {
+		return u, true
+	}
+	if updateTypes[u.Type] {
+		for _, n := range u.Nested() {
+			if n.Type == TypeActivity {
+				return n, true
+			}
+		}
+	}
+	return URN{}, false
+}
+
+// Profile returns the fsd_profile form of any profile URN that carries a
+// profile ID. Member URNs need a lookup; see ToProfile.
+func (u URN) Profile() (URN, bool) {
+	if profileTypes[u.Type] && !u.IsComposite() {
+		return New(TypeFSDProfile, u.ID), true
+	}
+	return URN{}, false
+}
+
+// Lookup resolves conversions that need the API. *api.Client satisfies it.
+type Lookup interface {
+	// ResolveActivity returns the activity URN for a share or ugcPost URN.
+	ResolveActivity(ctx context.Context, u URN) (URN, error)
+	// ResolveProfile returns the fsd_profile URN for a member URN.
+	ResolveProfile(ctx context.Context, u URN) (URN, error)
+	// ResolveMember returns the member URN for an fsd_profile URN.
+	ResolveMember(ctx context.Context, u URN) (URN, error)
+}
+
+// ToActivity converts a post URN to its activity form, using lookup for
+// share and ugcPost URNs. lookup may be nil.
+func ToActivity(ctx context.Context, u URN, lookup Lookup) (URN, error) {
+	if a, ok := u.Activity(); ok {
+		return a, nil
+	}
+	if u.Type != TypeShare && u.Type != TypeUGCPost {
+		return URN{}, fmt.Errorf("%s is not a post URN", u)
+	}
+	if lookup == nil {
+		return URN{}, fmt.Errorf("%s: %w", u, ErrNeedsLookup)
+	}
+	return lookup.ResolveActivity(ctx, u)
+}
+
+// ToProfile converts a profile URN to its fsd_profile form, using lookup
+// for member URNs. lookup may be nil.
+func ToProfile(ctx context.Context, u URN, lookup Lookup) (URN, error) {
+	if p, ok := u.Profile(); ok {
+		return p, nil
+	}
+	if u.Type != TypeMember {
+		return URN{}, fmt.Errorf("%s is not a profile URN", u)
+	}
+	if lookup == nil {
+		return URN{}, fmt.Errorf("%s: %w", u, ErrNeedsLookup)
+	}
+	return lookup.ResolveProfile(ctx, u)
+}
+
+// ToMember converts a profile URN to its member form, using lookup for
+// profile-ID URNs. lookup may be nil.
+func ToMember(ctx context.Context, u URN, lookup Lookup) (URN, error) {
+	if u.Type == TypeMember {
+		return u, nil
+	}
+	p, ok := u.Profile()
+	if !ok {
+		return URN{}, fmt.Errorf("%s is not a profile URN", u)
+	}
+	if lookup == nil {
+		return URN{}, fmt.Errorf("%s: %w", u, ErrNeedsLookup)
+	}
+	return lookup.ResolveMember(ctx, p)
+}
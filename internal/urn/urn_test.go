@@ -0,0 +1,151 @@
+package urn
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantType  string
+		wantID    string
+		wantKind  Kind
+		composite bool
+		wantErr   bool
+	}{
+		{input: "urn:li:activity:7123", wantType: "activity", wantID: "7123", wantKind: KindPost},
+		{input: "urn:li:member:123", wantType: "member", wantID: "123", wantKind: KindProfile},
+		{input: "urn:li:fsd_profile:ACoAAB1-x_Y", wantType: "fsd_profile", wantID: "ACoAAB1-x_Y", wantKind: KindProfile},
+		{
+			input:     "urn:li:fsd_update:(urn:li:activity:7123,MAIN_FEED,EMPTY,DEFAULT,false)",
+			wantType:  "fsd_update",
+			wantID:    "(urn:li:activity:7123,MAIN_FEED,EMPTY,DEFAULT,false)",
+			wantKind:  KindPost,
+			composite: true,
+		},
+		{
+			input:     "urn:li:comment:(activity:7123,456)",
+			wantType:  "comment",
+			wantID:    "(activity:7123,456)",
+			wantKind:  KindComment,
+			composite: true,
+		},
+		{input: "urn:li:fsd_school:5678", wantType: "fsd_school", wantID: "5678", wantKind: KindSchool},
+		{input: "urn:li:fsd_group:9806731", wantType: "fsd_group", wantID: "9806731", wantKind: KindGroup},
+		{input: "urn:li:fsd_event:7120000000000000000", wantType: "fsd_event", wantID: "7120000000000000000", wantKind: KindEvent},
+		{input: "urn:li:somethingNew:1", wantType: "somethingNew", wantID: "1", wantKind: KindUnknown},
+		{input: "urn:li:activity:", wantErr: true},
+		{input: "urn:li::1", wantErr: true},
+		{input: "urn:x:activity:1", wantErr: true},
+		{input: "activity:1", wantErr: true},
+		{input: "urn:li:fsd_update:(urn:li:activity:1,MAIN_FEED", wantErr: true},
+		{input: "urn:li:fsd_update:(a,b))", wantErr: true},
+		{input: "urn:li:fsd_update:(a,,b)", wantErr: true},
+		{input: "urn:li:fsd_update:(urn:li::1,b)", wantErr: true},
+		{input: "urn:li:activity:1,2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			u, err := Parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", u)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if u.Type != tt.wantType || u.ID != tt.wantID {
+				t.Errorf("Parse() = %+v, want type %q id %q", u, tt.wantType, tt.wantID)
+			}
+			if u.Kind() != tt.wantKind {
+				t.Errorf("Kind() = %q, want %q", u.Kind(), tt.wantKind)
+			}
+			if u.IsComposite() != tt.composite {
+				t.Errorf("IsComposite() = %v, want %v", u.IsComposite(), tt.composite)
+			}
+			if u.String() != tt.input {
+				t.Errorf("String() = %q, want %q", u.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestPretty(t *testing.T) {
+	tests := map[string]string{
+		"urn:li:activity:7123":                                                          "activity 7123",
+		"urn:li:fsd_update:(urn:li:activity:7123,MAIN_FEED)":                            "fsd_update(activity 7123, MAIN_FEED)",
+		"urn:li:fsd_entityResultViewModel:(urn:li:fsd_profile:ACoA,SEARCH_SRP,DEFAULT)": "fsd_entityResultViewModel(fsd_profile ACoA, SEARCH_SRP, DEFAULT)",
+	}
+	for input, want := range tests {
+		if got := MustParse(input).Pretty(); got != want {
+			t.Errorf("Pretty(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// fakeLookup records lookups and returns fixed answers.
+type fakeLookup struct {
+	calls int
+}
+
+func (f *fakeLookup) ResolveActivity(ctx context.Context, u URN) (URN, error) {
+	f.calls++
+	return New(TypeActivity, "99"), nil
+}
+
+func (f *fakeLookup) ResolveProfile(ctx context.Context, u URN) (URN, error) {
+	f.calls++
+	return New(TypeFSDProfile, "ACoA"), nil
+}
+
+func (f *fakeLookup) ResolveMember(ctx context.Context, u URN) (URN, error) {
+	f.calls++
+	return New(TypeMember, "123"), nil
+}
+
+func TestConversions(t *testing.T) {
+	ctx := context.Background()
+	lookup := &fakeLookup{}
+
+	// Local conversions never call the lookup.
+	a, err := ToActivity(ctx, MustParse("urn:li:fs_updateV2:(urn:li:activity:7,MAIN_FEED)"), lookup)
+	if err != nil || a.String() != "urn:li:activity:7" {
+		t.Errorf("ToActivity(update) = %v, %v", a, err)
+	}
+	p, err := ToProfile(ctx, MustParse("urn:li:fs_miniProfile:ACoA"), lookup)
+	if err != nil || p.String() != "urn:li:fsd_profile:ACoA" {
+		t.Errorf("ToProfile(miniProfile) = %v, %v", p, err)
+	}
+	if lookup.calls != 0 {
+		t.Errorf("lookup calls = %d, want 0", lookup.calls)
+	}
+
+	// Conversions that need the API.
+	if a, err := ToActivity(ctx, MustParse("urn:li:share:5"), lookup); err != nil || a.ID != "99" {
+		t.Errorf("ToActivity(share) = %v, %v", a, err)
+	}
+	if p, err := ToProfile(ctx, MustParse("urn:li:member:123"), lookup); err != nil || p.Type != TypeFSDProfile {
+		t.Errorf("ToProfile(member) = %v, %v", p, err)
+	}
+	if m, err := ToMember(ctx, MustParse("urn:li:fs_miniProfile:ACoA"), lookup); err != nil || m.String() != "urn:li:member:123" {
+		t.Errorf("ToMember(miniProfile) = %v, %v", m, err)
+	}
+	if lookup.calls != 3 {
+		t.Errorf("lookup calls = %d, want 3", lookup.calls)
+	}
+
+	// Without a lookup, the caller is told one is needed.
+	if _, err := ToActivity(ctx, MustParse("urn:li:ugcPost:5"), nil); !errors.Is(err, ErrNeedsLookup) {
+		t.Errorf("ToActivity without lookup error = %v, want ErrNeedsLookup", err)
+	}
+
+	// Kind mismatches are rejected.
+	if _, err := ToProfile(ctx, MustParse("urn:li:activity:1"), lookup); err == nil {
+		t.Error("expected error converting a post to a profile")
+	}
+}